@@ -1,6 +1,11 @@
 package assert
 
-import "golang.org/x/exp/constraints"
+import (
+	"cmp"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
 
 // ThatString starts assertions on a string.
 func ThatString(t TestingT, actual string) *StringAssert {
@@ -18,6 +23,14 @@ func ThatSlice[T ~[]E, E any](t TestingT, actual T) *SliceAssert[E] {
 	return newSliceAssert(t, actual)
 }
 
+// ThatMap starts assertions on a map.
+func ThatMap[M ~map[K]V, K comparable, V any](t TestingT, actual M) *MapAssert[K, V] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newMapAssert(t, actual)
+}
+
 // ThatBool starts assertions on a bool.
 func ThatBool(t TestingT, actual bool) *BoolAssert {
 	if h, ok := t.(tHelper); ok {
@@ -42,6 +55,39 @@ func ThatFloat[T constraints.Float](t TestingT, actual T) *FloatAssert[T] {
 	return newFloatAssert(t, actual)
 }
 
+// ThatFloats starts assertions on a slice of floats.
+func ThatFloats[T ~[]E, E constraints.Float](t TestingT, actual T) *FloatsAssert[E] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newFloatsAssert(t, actual)
+}
+
+// ThatMatrix starts assertions on a matrix of floats, given as a slice of rows.
+func ThatMatrix[M ~[][]E, E constraints.Float](t TestingT, actual M) *MatrixAssert[E] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newMatrixAssert(t, actual)
+}
+
+// ThatOrdered starts assertions on a value of any ordered type, compared with cmp.Compare.
+func ThatOrdered[T cmp.Ordered](t TestingT, actual T) *OrderedAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newOrderedAssert(t, actual, cmp.Compare[T])
+}
+
+// ThatComparable starts assertions on a value that is compared with the given function,
+// which returns a negative number, zero or a positive number like cmp.Compare.
+func ThatComparable[T any](t TestingT, actual T, compare func(a, b T) int) *OrderedAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newOrderedAssert(t, actual, compare)
+}
+
 // ThatError starts assertions on an error.
 func ThatError(t TestingT, actual error) *ErrorAssert {
 	if h, ok := t.(tHelper); ok {
@@ -49,3 +95,71 @@ func ThatError(t TestingT, actual error) *ErrorAssert {
 	}
 	return newErrorAssert(t, actual)
 }
+
+// ErrorAs finds the first error in the chain of the actual error that matches type T
+// and starts assertions on it. The assertion fails if there is no such error.
+//
+//	assert.ErrorAs[*fs.PathError](t, err).
+//	       Satisfies(func(e *fs.PathError) bool { return e.Op == "open" })
+func ErrorAs[T error](t TestingT, actual error) *ObjectAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return errorAs[T](newErrorAssert(t, actual))
+}
+
+// ThatObject starts assertions on an arbitrary value.
+func ThatObject[T any](t TestingT, actual T) *ObjectAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newObjectAssert(t, actual)
+}
+
+// ThatPointer starts assertions on a pointer.
+func ThatPointer[T any](t TestingT, actual *T) *PointerAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newPointerAssert(t, actual)
+}
+
+// ThatResult starts assertions on the value and error returned by a call, captured with ResultOf.
+func ThatResult[T any](t TestingT, actual Result[T]) *ResultAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newResultAssert(t, actual)
+}
+
+// ThatOk starts assertions on the value and ok flag returned by a lookup, captured with LookupOf.
+func ThatOk[T any](t TestingT, actual Lookup[T]) *OkAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newOkAssert(t, actual)
+}
+
+// ThatTime starts assertions on a time.
+func ThatTime(t TestingT, actual time.Time) *TimeAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newTimeAssert(t, actual)
+}
+
+// ThatDuration starts assertions on a duration.
+func ThatDuration(t TestingT, actual time.Duration) *DurationAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newDurationAssert(t, actual)
+}
+
+// ThatCode runs the given function and starts assertions on whether it panics.
+func ThatCode(t TestingT, code func()) *CodeAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return newCodeAssert(t, code)
+}
@@ -1,14 +1,19 @@
 package assert
 
 type BaseAssert[T any] struct {
-	t    TestingT
-	info *WritableAssertionInfo
-	a    *T
+	t      TestingT
+	info   *WritableAssertionInfo
+	a      *T
+	mode   failureMode
+	failed bool
+	// skipped is set for assertions derived from a failed assertion, their actual value is meaningless.
+	skipped bool
 }
 
 // NewBaseAssert creates and returns a new BaseAssert for constructing derived assertions.
 func NewBaseAssert[T any](t TestingT, info *WritableAssertionInfo, assertion *T) *BaseAssert[T] {
-	return &BaseAssert[T]{t, info, assertion}
+	t, mode := unwrapT(t)
+	return &BaseAssert[T]{t: t, info: info, a: assertion, mode: mode}
 }
 
 // DescribedAs sets an optional description for the following assertion.
@@ -56,17 +61,57 @@ func (a *BaseAssert[T]) InBinary() *T {
 	return a.a
 }
 
-// FailWithMessage records an assertion error
+// InFloatBits uses the IEEE 754 bit pattern to describe float values in error messages.
+//
+//	// assertion will fail with message:
+//	// expected value to be negative zero, but got <0x0000000000000000>
+//	assert.ThatFloat(t, 0.0).
+//	       InFloatBits().
+//	       IsNegativeZero()
+func (a *BaseAssert[T]) InFloatBits() *T {
+	a.info.UsingFloatBitsRepresentation()
+	return a.a
+}
+
+// HasFailed returns if an assertion of this chain has already failed.
+func (a *BaseAssert[T]) HasFailed() bool {
+	return a.failed
+}
+
+// FailWithMessage records an assertion error.
+// Only the first failure of an assertion chain is recorded, the following assertions of the chain are skipped.
+// Assertions reporting to SoftAssertions record every failure of the chain,
+// except for assertions derived from an already failed assertion.
 func (a *BaseAssert[T]) FailWithMessage(message string, args ...any) {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
+	if a.skipped {
+		return
+	}
+	if _, soft := a.t.(*SoftAssertions); a.failed && !soft {
+		return
+	}
+	a.failed = true
 	description := a.info.Description()
 	overridingErrorMessage := a.info.OverridingFailureMessage()
 	representation := a.info.Representation()
+	var formatted string
 	if overridingErrorMessage != "" {
-		a.t.Errorf(messageFormatter.Format(description, representation, overridingErrorMessage))
+		formatted = messageFormatter.Format(description, representation, overridingErrorMessage)
 	} else {
-		a.t.Errorf(messageFormatter.Format(description, representation, message, args...))
+		formatted = messageFormatter.Format(description, representation, message, args...)
 	}
+	report(a.t, a.mode, formatted)
+}
+
+// inheritFailure marks an assertion derived from a failed assertion as failed and skips its assertions.
+func (a *BaseAssert[T]) inheritFailure(failed bool) {
+	a.failed = failed
+	a.skipped = failed
+}
+
+// testingT returns the TestingT for assertions derived from this one, keeping its failure mode.
+func (a *BaseAssert[T]) testingT() TestingT {
+	return wrapT(a.t, a.mode)
 }
@@ -2,6 +2,7 @@ package assert
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 )
@@ -92,3 +93,30 @@ func TestBinaryRepresentation(t *testing.T) {
 		t.Errorf("expected assertion error message to contain %s, but got %s", "101010", fixture.message)
 	}
 }
+
+func TestFailedChainSkipsFollowingAssertions(t *testing.T) {
+	fixture := new(fixtureT)
+	baseAssert := &BaseAssert[DummyAssert]{t: fixture, info: NewWritableAssertionInfo()}
+
+	baseAssert.FailWithMessage("first failure")
+	baseAssert.FailWithMessage("second failure")
+
+	if !baseAssert.HasFailed() {
+		t.Errorf("expected assertion chain to have failed")
+	}
+	if fixture.message != "first failure" {
+		t.Errorf("expected assertion error message to be %s, but got %s", "first failure", fixture.message)
+	}
+}
+
+func TestFloatBitsRepresentation(t *testing.T) {
+	fixture := new(fixtureT)
+	baseAssert := &BaseAssert[DummyAssert]{t: fixture, info: NewWritableAssertionInfo()}
+
+	baseAssert.InFloatBits()
+	baseAssert.FailWithMessage("expected %s, but got %s", math.Copysign(0, -1), "Frodo")
+
+	if !strings.Contains(fixture.message, "expected <0x8000000000000000>, but got <Frodo>") {
+		t.Errorf("expected assertion error message to contain %s, but got %s", "<0x8000000000000000>", fixture.message)
+	}
+}
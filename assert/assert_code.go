@@ -0,0 +1,189 @@
+package assert
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/skhome/assertg/check"
+)
+
+// CodeAssert provides assertions on whether a function panics.
+type CodeAssert struct {
+	*BaseAssert[CodeAssert]
+	panicked  bool
+	recovered any
+	stack     string
+}
+
+// newCodeAssert runs the given function and returns a new CodeAssert on its outcome.
+func newCodeAssert(t TestingT, code func()) *CodeAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	codeAssert := &CodeAssert{}
+	codeAssert.panicked, codeAssert.recovered, codeAssert.stack = runCode(code)
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), codeAssert)
+	codeAssert.BaseAssert = baseAssert
+	return codeAssert
+}
+
+// DoesNotPanic verifies that the function does not panic.
+//
+//	// assertion will pass
+//	assert.ThatCode(t, func() { strconv.Itoa(42) }).DoesNotPanic()
+//
+//	// assertion will fail
+//	assert.ThatCode(t, func() { panic("boom") }).DoesNotPanic()
+func (a *CodeAssert) DoesNotPanic() *CodeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.panicked {
+		a.FailWithMessage("expected code not to panic, but it panicked with %s"+a.formattedStack(), a.recovered)
+	}
+	return a
+}
+
+// Panics verifies that the function panics.
+//
+//	// assertion will pass
+//	assert.ThatCode(t, func() { panic("boom") }).Panics()
+//
+//	// assertion will fail
+//	assert.ThatCode(t, func() { strconv.Itoa(42) }).Panics()
+func (a *CodeAssert) Panics() *CodeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.panicked {
+		a.FailWithMessage("expected code to panic, but it did not")
+	}
+	return a
+}
+
+// PanicsWithValue verifies that the function panics with a value equal to the given one.
+//
+//	// assertion will pass
+//	assert.ThatCode(t, func() { panic("boom") }).PanicsWithValue("boom")
+//
+//	// assertions will fail
+//	assert.ThatCode(t, func() { panic("boom") }).PanicsWithValue("bang")
+//	assert.ThatCode(t, func() { strconv.Itoa(42) }).PanicsWithValue("boom")
+func (a *CodeAssert) PanicsWithValue(expected any) *CodeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.panicked {
+		a.FailWithMessage("expected code to panic with %s, but it did not panic", expected)
+	} else if !check.ObjectsAreEqual(expected, a.recovered) {
+		a.FailWithMessage("expected code to panic with %s, but it panicked with %s"+a.formattedStack(), expected, a.recovered)
+	}
+	return a
+}
+
+// PanicsWithError verifies that the function panics with an error.
+// The recovered error becomes the new object under test.
+//
+//	// assertion will pass
+//	assert.ThatCode(t, func() { panic(io.EOF) }).
+//	       PanicsWithError().
+//	       Is(io.EOF)
+//
+//	// assertion will fail
+//	assert.ThatCode(t, func() { panic("boom") }).PanicsWithError()
+func (a *CodeAssert) PanicsWithError() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	err, isError := a.recovered.(error)
+	if !a.panicked {
+		a.FailWithMessage("expected code to panic with an error, but it did not panic")
+	} else if !isError {
+		a.FailWithMessage("expected code to panic with an error, but it panicked with %s"+a.formattedStack(), a.recovered)
+	}
+	errorAssert := newErrorAssert(a.testingT(), err)
+	errorAssert.inheritFailure(a.failed)
+	return errorAssert
+}
+
+// PanicsWithMessageContaining verifies that the function panics with a message containing the given string.
+// The message is the text of a recovered error or the formatted recovered value otherwise.
+// The message becomes the new object under test.
+//
+//	// assertion will pass
+//	assert.ThatCode(t, func() { panic("index out of range") }).
+//	       PanicsWithMessageContaining("out of range").
+//	       StartsWith("index")
+//
+//	// assertion will fail
+//	assert.ThatCode(t, func() { panic("boom") }).PanicsWithMessageContaining("bang")
+func (a *CodeAssert) PanicsWithMessageContaining(substring string) *StringAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	message := panicMessage(a.recovered)
+	if !a.panicked {
+		a.FailWithMessage("expected code to panic with a message containing %s, but it did not panic", substring)
+	} else if !strings.Contains(message, substring) {
+		a.FailWithMessage("expected code to panic with a message containing %s, but it panicked with %s"+a.formattedStack(),
+			substring, a.recovered)
+	}
+	stringAssert := newStringAssert(a.testingT(), message)
+	stringAssert.inheritFailure(a.failed)
+	return stringAssert
+}
+
+// formattedStack returns the stack of the recovered panic to be appended to a failure message.
+func (a *CodeAssert) formattedStack() string {
+	return escapeFormat("\nstack:\n" + a.stack)
+}
+
+// runCode runs the given function and returns whether it panicked, the recovered value and the trimmed stack.
+func runCode(code func()) (panicked bool, recovered any, stack string) {
+	panicked = true
+	defer func() {
+		if panicked {
+			recovered = recover()
+			stack = trimStack(string(debug.Stack()))
+		}
+	}()
+	code()
+	panicked = false
+	return
+}
+
+// trimStack removes the frames of the runtime and the recovering function from a goroutine stack,
+// keeping the frames from the panicking function up to the function passed to runCode.
+func trimStack(stack string) string {
+	lines := strings.Split(strings.TrimSpace(stack), "\n")
+	// the first line is the goroutine header, followed by a function and a file line per frame
+	start, end := 1, len(lines)
+	for i := 1; i+1 < len(lines); i += 2 {
+		if strings.HasPrefix(lines[i], "panic(") {
+			start = i + 2
+		}
+	}
+	for start+1 < len(lines) && strings.HasPrefix(lines[start], "runtime.") {
+		start += 2
+	}
+	for i := start; i+1 < len(lines); i += 2 {
+		if strings.Contains(lines[i], "/assert.runCode(") {
+			end = i
+			break
+		}
+	}
+	return strings.Join(lines[start:end], "\n")
+}
+
+// panicMessage returns the message of a recovered panic value.
+func panicMessage(recovered any) string {
+	switch value := recovered.(type) {
+	case nil:
+		return ""
+	case error:
+		return value.Error()
+	default:
+		return fmt.Sprint(value)
+	}
+}
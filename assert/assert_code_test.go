@@ -0,0 +1,110 @@
+package assert_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/skhome/assertg/assert"
+)
+
+func noPanic() {}
+
+func panicWith(value any) func() {
+	return func() { panic(value) }
+}
+
+func TestCodeDoesNotPanic(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatCode(fixture, noPanic).DoesNotPanic()
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, panicWith("boom")).DoesNotPanic()
+	assertErrorMessage(t, fixture, "expected code not to panic, but it panicked with <boom>\nstack:\n")
+	assertErrorMessage(t, fixture, "assert_test.panicWith.func1()")
+}
+
+func TestCodeDoesNotPanicTrimsStack(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatCode(fixture, func() {
+		var values []int
+		_ = values[3]
+	}).DoesNotPanic()
+
+	assertErrorMessage(t, fixture, "but it panicked with <runtime error: index out of range [3] with length 0>\nstack:\ngithub.com/skhome/assertg/assert_test.TestCodeDoesNotPanicTrimsStack.func1()")
+	for _, frame := range []string{"runtime/debug.Stack", "panic(", "assert.runCode"} {
+		if strings.Contains(fixture.message, frame) {
+			t.Errorf("expected stack to be trimmed, but got frame %s in %s", frame, fixture.message)
+		}
+	}
+}
+
+func TestCodePanics(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatCode(fixture, panicWith("boom")).Panics()
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, panicWith(nil)).Panics()
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, noPanic).Panics()
+	assertErrorMessage(t, fixture, "expected code to panic, but it did not")
+}
+
+func TestCodePanicsWithValue(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatCode(fixture, panicWith(42)).PanicsWithValue(42)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, panicWith("boom")).PanicsWithValue("bang")
+	assertErrorMessage(t, fixture, "expected code to panic with <bang>, but it panicked with <boom>\nstack:\n")
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, noPanic).PanicsWithValue("boom")
+	assertErrorMessage(t, fixture, "expected code to panic with <boom>, but it did not panic")
+}
+
+func TestCodePanicsWithError(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatCode(fixture, panicWith(io.EOF)).PanicsWithError().Is(io.EOF)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, panicWith(io.EOF)).PanicsWithError().Is(io.ErrUnexpectedEOF)
+	assertErrorMessage(t, fixture, "expected error to have <unexpected EOF> in its error chain, but got <EOF>")
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, panicWith("boom")).PanicsWithError().IsNotNil()
+	assertErrorMessage(t, fixture, "expected code to panic with an error, but it panicked with <boom>\nstack:\n")
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, noPanic).PanicsWithError().HasMessage("boom")
+	assertErrorMessage(t, fixture, "expected code to panic with an error, but it did not panic")
+}
+
+func TestCodePanicsWithMessageContaining(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatCode(fixture, panicWith("index out of range")).PanicsWithMessageContaining("out of").StartsWith("index")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, panicWith(errors.New("connection refused"))).PanicsWithMessageContaining("refused")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, panicWith("boom")).PanicsWithMessageContaining("boom").EndsWith("bang")
+	assertErrorMessage(t, fixture, "expected string to end with <bang>, but got <boom>")
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, panicWith("boom")).PanicsWithMessageContaining("bang").IsEmpty()
+	assertErrorMessage(t, fixture, "expected code to panic with a message containing <bang>, but it panicked with <boom>\nstack:\n")
+
+	fixture = new(fixtureT)
+	assert.ThatCode(fixture, noPanic).PanicsWithMessageContaining("boom").IsNotEmpty()
+	assertErrorMessage(t, fixture, "expected code to panic with a message containing <boom>, but it did not panic")
+}
@@ -0,0 +1,255 @@
+package assert
+
+import (
+	"time"
+
+	"github.com/skhome/assertg/check"
+)
+
+// DurationAssert provides assertions on durations.
+type DurationAssert struct {
+	*BaseAssert[DurationAssert]
+	actual time.Duration
+}
+
+// newDurationAssert creates and returns a new DurationAssert.
+func newDurationAssert(t TestingT, actual time.Duration) *DurationAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	durationAssert := &DurationAssert{actual: actual}
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), durationAssert)
+	durationAssert.BaseAssert = baseAssert
+	return durationAssert
+}
+
+// IsEqualTo verifies that the actual duration is equal to the given one.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, 1500*time.Millisecond).IsEqualTo(1500 * time.Millisecond)
+//
+//	// assertion will fail
+//	assert.ThatDuration(t, 1500*time.Millisecond).IsEqualTo(time.Second)
+func (a *DurationAssert) IsEqualTo(expected time.Duration) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual != expected {
+		a.FailWithMessage("expected duration to equal %s, but got %s", expected, a.actual)
+	}
+	return a
+}
+
+// IsNotEqualTo verifies that the actual duration is not equal to the given one.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, 1500*time.Millisecond).IsNotEqualTo(time.Second)
+//
+//	// assertion will fail
+//	assert.ThatDuration(t, 1500*time.Millisecond).IsNotEqualTo(1500 * time.Millisecond)
+func (a *DurationAssert) IsNotEqualTo(expected time.Duration) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual == expected {
+		a.FailWithMessage("expected duration not to equal %s, but got %s", expected, a.actual)
+	}
+	return a
+}
+
+// IsZero verifies that the actual duration is zero.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, 0).IsZero()
+//
+//	// assertion will fail
+//	assert.ThatDuration(t, time.Second).IsZero()
+func (a *DurationAssert) IsZero() *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual != 0 {
+		a.FailWithMessage("expected duration to be zero, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsPositive verifies that the actual duration is greater than zero.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, time.Second).IsPositive()
+//
+//	// assertions will fail
+//	assert.ThatDuration(t, 0).IsPositive()
+//	assert.ThatDuration(t, -time.Second).IsPositive()
+func (a *DurationAssert) IsPositive() *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual <= 0 {
+		a.FailWithMessage("expected duration to be positive, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsNegative verifies that the actual duration is less than zero.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, -time.Second).IsNegative()
+//
+//	// assertions will fail
+//	assert.ThatDuration(t, 0).IsNegative()
+//	assert.ThatDuration(t, time.Second).IsNegative()
+func (a *DurationAssert) IsNegative() *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual >= 0 {
+		a.FailWithMessage("expected duration to be negative, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsShorterThan verifies that the actual duration is shorter than the given one.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, time.Second).IsShorterThan(time.Minute)
+//
+//	// assertions will fail
+//	assert.ThatDuration(t, time.Minute).IsShorterThan(time.Minute)
+//	assert.ThatDuration(t, time.Hour).IsShorterThan(time.Minute)
+func (a *DurationAssert) IsShorterThan(other time.Duration) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual >= other {
+		a.FailWithMessage("expected duration to be shorter than %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsShorterThanOrEqualTo verifies that the actual duration is shorter than or equal to the given one.
+//
+//	// assertions will pass
+//	assert.ThatDuration(t, time.Second).IsShorterThanOrEqualTo(time.Minute)
+//	assert.ThatDuration(t, time.Minute).IsShorterThanOrEqualTo(time.Minute)
+//
+//	// assertion will fail
+//	assert.ThatDuration(t, time.Hour).IsShorterThanOrEqualTo(time.Minute)
+func (a *DurationAssert) IsShorterThanOrEqualTo(other time.Duration) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual > other {
+		a.FailWithMessage("expected duration to be shorter than or equal to %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsLongerThan verifies that the actual duration is longer than the given one.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, time.Hour).IsLongerThan(time.Minute)
+//
+//	// assertions will fail
+//	assert.ThatDuration(t, time.Minute).IsLongerThan(time.Minute)
+//	assert.ThatDuration(t, time.Second).IsLongerThan(time.Minute)
+func (a *DurationAssert) IsLongerThan(other time.Duration) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual <= other {
+		a.FailWithMessage("expected duration to be longer than %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsLongerThanOrEqualTo verifies that the actual duration is longer than or equal to the given one.
+//
+//	// assertions will pass
+//	assert.ThatDuration(t, time.Hour).IsLongerThanOrEqualTo(time.Minute)
+//	assert.ThatDuration(t, time.Minute).IsLongerThanOrEqualTo(time.Minute)
+//
+//	// assertion will fail
+//	assert.ThatDuration(t, time.Second).IsLongerThanOrEqualTo(time.Minute)
+func (a *DurationAssert) IsLongerThanOrEqualTo(other time.Duration) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual < other {
+		a.FailWithMessage("expected duration to be longer than or equal to %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsBetween verifies that the actual duration is between the start and end duration (inclusive).
+//
+//	// assertions will pass
+//	assert.ThatDuration(t, time.Minute).IsBetween(time.Second, time.Hour)
+//	assert.ThatDuration(t, time.Minute).IsBetween(time.Minute, time.Hour)
+//
+//	// assertion will fail
+//	assert.ThatDuration(t, time.Second).IsBetween(time.Minute, time.Hour)
+func (a *DurationAssert) IsBetween(startInclusive, endInclusive time.Duration) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.DurationIsBetween(a.actual, startInclusive, endInclusive) {
+		a.FailWithMessage("expected duration to be between %s and %s, but got %s", startInclusive, endInclusive, a.actual)
+	}
+	return a
+}
+
+// IsCloseTo verifies that the actual duration differs from the given one by at most the given tolerance.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, 1010*time.Millisecond).IsCloseTo(time.Second, 50*time.Millisecond)
+//
+//	// assertion will fail
+//	assert.ThatDuration(t, 1100*time.Millisecond).IsCloseTo(time.Second, 50*time.Millisecond)
+func (a *DurationAssert) IsCloseTo(expected, tolerance time.Duration) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.DurationIsCloseTo(a.actual, expected, tolerance) {
+		a.FailWithMessage("expected duration to be close to %s within %s, but got %s with a difference of %s",
+			expected, tolerance, a.actual, a.actual-expected)
+	}
+	return a
+}
+
+// IsCloseToPercentage verifies that the actual duration differs from the given one by at most
+// the given percentage of the given duration.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, 1010*time.Millisecond).IsCloseToPercentage(time.Second, 5)
+//
+//	// assertion will fail
+//	assert.ThatDuration(t, 1100*time.Millisecond).IsCloseToPercentage(time.Second, 5)
+func (a *DurationAssert) IsCloseToPercentage(expected time.Duration, percentage float64) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.DurationIsCloseToPercentage(a.actual, expected, percentage) {
+		a.FailWithMessage("expected duration to be close to %s within %s percent (%s), but got %s with a difference of %s",
+			expected, percentage, check.DurationPercentage(expected, percentage), a.actual, a.actual-expected)
+	}
+	return a
+}
+
+// IsRoundedTo verifies that the actual duration is a multiple of the given unit.
+//
+//	// assertion will pass
+//	assert.ThatDuration(t, 3*time.Second).IsRoundedTo(time.Second)
+//
+//	// assertion will fail
+//	assert.ThatDuration(t, 1500*time.Millisecond).IsRoundedTo(time.Second)
+func (a *DurationAssert) IsRoundedTo(unit time.Duration) *DurationAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.DurationIsRoundedTo(a.actual, unit) {
+		a.FailWithMessage("expected duration to be rounded to %s, but got %s", unit, a.actual)
+	}
+	return a
+}
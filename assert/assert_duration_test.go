@@ -0,0 +1,186 @@
+package assert_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+)
+
+type durationTest struct {
+	actual     time.Duration
+	other      time.Duration
+	end        time.Duration
+	percentage float64
+	ok         bool
+}
+
+func TestDurationIsEqualTo(t *testing.T) {
+	tests := []durationTest{
+		{actual: 1500 * time.Millisecond, other: 1500 * time.Millisecond, ok: true},
+		{actual: 1500 * time.Millisecond, other: time.Second, ok: false},
+	}
+	messageFormat := "expected duration to equal <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
+
+func TestDurationIsNotEqualTo(t *testing.T) {
+	tests := []durationTest{
+		{actual: 1500 * time.Millisecond, other: time.Second, ok: true},
+		{actual: 1500 * time.Millisecond, other: 1500 * time.Millisecond, ok: false},
+	}
+	messageFormat := "expected duration not to equal <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsNotEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
+
+func TestDurationIsZero(t *testing.T) {
+	tests := []durationTest{
+		{actual: 0, ok: true},
+		{actual: time.Nanosecond, ok: false},
+	}
+	messageFormat := "expected duration to be zero, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsZero()
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestDurationIsPositive(t *testing.T) {
+	tests := []durationTest{
+		{actual: time.Second, ok: true},
+		{actual: 0, ok: false},
+		{actual: -time.Second, ok: false},
+	}
+	messageFormat := "expected duration to be positive, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsPositive()
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestDurationIsNegative(t *testing.T) {
+	tests := []durationTest{
+		{actual: -time.Second, ok: true},
+		{actual: 0, ok: false},
+		{actual: time.Second, ok: false},
+	}
+	messageFormat := "expected duration to be negative, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsNegative()
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestDurationIsShorterThan(t *testing.T) {
+	tests := []durationTest{
+		{actual: time.Second, other: time.Minute, ok: true},
+		{actual: time.Minute, other: time.Minute, ok: false},
+		{actual: time.Hour, other: time.Minute, ok: false},
+	}
+	messageFormat := "expected duration to be shorter than <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsShorterThan(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
+
+func TestDurationIsShorterThanOrEqualTo(t *testing.T) {
+	tests := []durationTest{
+		{actual: time.Second, other: time.Minute, ok: true},
+		{actual: time.Minute, other: time.Minute, ok: true},
+		{actual: time.Hour, other: time.Minute, ok: false},
+	}
+	messageFormat := "expected duration to be shorter than or equal to <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsShorterThanOrEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
+
+func TestDurationIsLongerThan(t *testing.T) {
+	tests := []durationTest{
+		{actual: time.Hour, other: time.Minute, ok: true},
+		{actual: time.Minute, other: time.Minute, ok: false},
+		{actual: time.Second, other: time.Minute, ok: false},
+	}
+	messageFormat := "expected duration to be longer than <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsLongerThan(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
+
+func TestDurationIsLongerThanOrEqualTo(t *testing.T) {
+	tests := []durationTest{
+		{actual: time.Hour, other: time.Minute, ok: true},
+		{actual: time.Minute, other: time.Minute, ok: true},
+		{actual: time.Second, other: time.Minute, ok: false},
+	}
+	messageFormat := "expected duration to be longer than or equal to <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsLongerThanOrEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
+
+func TestDurationIsBetween(t *testing.T) {
+	tests := []durationTest{
+		{actual: time.Minute, other: time.Second, end: time.Hour, ok: true},
+		{actual: time.Minute, other: time.Minute, end: time.Hour, ok: true},
+		{actual: time.Hour, other: time.Minute, end: time.Hour, ok: true},
+		{actual: time.Second, other: time.Minute, end: time.Hour, ok: false},
+	}
+	messageFormat := "expected duration to be between <%s> and <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsBetween(test.other, test.end)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.end, test.actual)
+	})
+}
+
+func TestDurationIsCloseTo(t *testing.T) {
+	tests := []durationTest{
+		{actual: 1050 * time.Millisecond, other: time.Second, end: 50 * time.Millisecond, ok: true},
+		{actual: 950 * time.Millisecond, other: time.Second, end: 50 * time.Millisecond, ok: true},
+		{actual: 1100 * time.Millisecond, other: time.Second, end: 50 * time.Millisecond, ok: false},
+	}
+	messageFormat := "expected duration to be close to <%s> within <%s>, but got <%s> with a difference of <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsCloseTo(test.other, test.end)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.end, test.actual, test.actual-test.other)
+	})
+}
+
+func TestDurationIsCloseToPercentage(t *testing.T) {
+	tests := []durationTest{
+		{actual: 1050 * time.Millisecond, other: time.Second, percentage: 5, ok: true},
+		{actual: 950 * time.Millisecond, other: time.Second, percentage: 5, ok: true},
+		{actual: -950 * time.Millisecond, other: -time.Second, percentage: 5, ok: true},
+		{actual: 900 * time.Millisecond, other: time.Second, percentage: 5, ok: false},
+	}
+	messageFormat := "expected duration to be close to <%s> within <%v> percent (<50ms>), but got <%s> with a difference of <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsCloseToPercentage(test.other, test.percentage)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.percentage, test.actual, test.actual-test.other)
+	})
+}
+
+func TestDurationIsRoundedTo(t *testing.T) {
+	tests := []durationTest{
+		{actual: 3 * time.Second, other: time.Second, ok: true},
+		{actual: 0, other: time.Second, ok: true},
+		{actual: 1500 * time.Millisecond, other: time.Second, ok: false},
+		{actual: time.Second, other: 0, ok: false},
+	}
+	messageFormat := "expected duration to be rounded to <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test durationTest) (bool, string) {
+		assert.ThatDuration(fixture, test.actual).IsRoundedTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
@@ -1,7 +1,13 @@
 package assert
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"strings"
+	"syscall"
 
 	"github.com/skhome/assertg/check"
 )
@@ -110,6 +116,9 @@ func (a *ErrorAssert) HasMessage(message string) *ErrorAssert {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
+	if !a.hasError() {
+		return a
+	}
 	if !check.StringIsEqual(a.actual.Error(), message) {
 		a.FailWithMessage("expected error to have message %s, but got %s", message, a.actual.Error())
 	}
@@ -129,6 +138,9 @@ func (a *ErrorAssert) DoesNotHaveMessage(message string) *ErrorAssert {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
+	if !a.hasError() {
+		return a
+	}
 	if check.StringIsEqual(a.actual.Error(), message) {
 		a.FailWithMessage("expected error not to have message %s, but got %s", message, a.actual.Error())
 	}
@@ -152,6 +164,9 @@ func (a *ErrorAssert) HasMessageContaining(values ...string) *ErrorAssert {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
+	if !a.hasError() {
+		return a
+	}
 	if !check.StringContains(a.actual.Error(), values) {
 		a.FailWithMessage("expected error to have message containing %s, but got %s", values, a.actual.Error())
 	}
@@ -174,6 +189,9 @@ func (a *ErrorAssert) HasMessageContainingAnyOf(values ...string) *ErrorAssert {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
+	if !a.hasError() {
+		return a
+	}
 	if !check.StringContainsAny(a.actual.Error(), values) {
 		a.FailWithMessage("expected error to have message containing any of %s, but got %s", values, a.actual.Error())
 	}
@@ -195,6 +213,9 @@ func (a *ErrorAssert) HasMessageNotContaining(content string) *ErrorAssert {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
+	if !a.hasError() {
+		return a
+	}
 	if check.StringContains(a.actual.Error(), []string{content}) {
 		a.FailWithMessage("expected error not to have message containing %s, but got %s", content, a.actual.Error())
 	}
@@ -216,6 +237,9 @@ func (a *ErrorAssert) HasMessageStartingWith(prefix string) *ErrorAssert {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
+	if !a.hasError() {
+		return a
+	}
 	if !check.StringStartsWith(a.actual.Error(), prefix) {
 		a.FailWithMessage("expected error to have message starting with %s, but got %s", prefix, a.actual.Error())
 	}
@@ -237,8 +261,538 @@ func (a *ErrorAssert) HasMessageEndingWith(suffix string) *ErrorAssert {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
+	if !a.hasError() {
+		return a
+	}
 	if !check.StringEndsWith(a.actual.Error(), suffix) {
 		a.FailWithMessage("expected error to have message ending with %s, but got %s", suffix, a.actual.Error())
 	}
 	return a
 }
+
+// IsNotExist verifies that the actual error reports that a file or directory does not exist,
+// i.e. that it matches fs.ErrNotExist.
+//
+//	_, err := os.Open("missing.txt")
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).IsNotExist()
+//
+//	// assertion will fail
+//	assert.ThatError(t, fs.ErrPermission).IsNotExist()
+func (a *ErrorAssert) IsNotExist() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.hasError() && !errors.Is(a.actual, fs.ErrNotExist) {
+		a.FailWithMessage("expected error to be a not exist error (fs.ErrNotExist), but got %s", a.actual)
+	}
+	return a
+}
+
+// IsPermission verifies that the actual error reports a lack of permission, i.e. that it matches fs.ErrPermission.
+//
+//	// assertion will pass
+//	assert.ThatError(t, fmt.Errorf("open config: %w", syscall.EACCES)).IsPermission()
+//
+//	// assertion will fail
+//	assert.ThatError(t, fs.ErrNotExist).IsPermission()
+func (a *ErrorAssert) IsPermission() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.hasError() && !errors.Is(a.actual, fs.ErrPermission) {
+		a.FailWithMessage("expected error to be a permission error (fs.ErrPermission), but got %s", a.actual)
+	}
+	return a
+}
+
+// IsTimeout verifies that any error in the tree of the actual error reports a timeout with a Timeout() bool method,
+// like net.Error, os.ErrDeadlineExceeded or context.DeadlineExceeded.
+//
+//	// assertion will pass
+//	assert.ThatError(t, fmt.Errorf("fetch: %w", context.DeadlineExceeded)).IsTimeout()
+//
+//	// assertion will fail
+//	assert.ThatError(t, context.Canceled).IsTimeout()
+func (a *ErrorAssert) IsTimeout() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.hasError() && !check.ErrorIsTimeout(a.actual) {
+		a.FailWithMessage("expected error to be a timeout error, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsTemporary verifies that any error in the tree of the actual error reports a temporary condition
+// with a Temporary() bool method.
+//
+//	// assertion will pass
+//	assert.ThatError(t, syscall.EAGAIN).IsTemporary()
+//
+//	// assertion will fail
+//	assert.ThatError(t, syscall.ENOENT).IsTemporary()
+func (a *ErrorAssert) IsTemporary() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.hasError() && !check.ErrorIsTemporary(a.actual) {
+		a.FailWithMessage("expected error to be a temporary error, but got %s", a.actual)
+	}
+	return a
+}
+
+// HasErrno verifies that the tree of the actual error contains the given system call error number.
+//
+//	_, err := os.Open("missing.txt")
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).HasErrno(syscall.ENOENT)
+//
+//	// assertion will fail
+//	assert.ThatError(t, err).HasErrno(syscall.EACCES)
+func (a *ErrorAssert) HasErrno(errno syscall.Errno) *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.hasError() || errors.Is(a.actual, errno) {
+		return a
+	}
+	var actualErrno syscall.Errno
+	if errors.As(a.actual, &actualErrno) {
+		a.FailWithMessage("expected error to have errno %s (%s), but got %s with errno %s (%s)",
+			uintptr(errno), errno, a.actual, uintptr(actualErrno), actualErrno)
+	} else {
+		a.FailWithMessage("expected error to have errno %s (%s), but got %s without an errno", uintptr(errno), errno, a.actual)
+	}
+	return a
+}
+
+// IsContextCanceled verifies that the actual error reports a canceled context, i.e. that it matches context.Canceled.
+//
+//	// assertion will pass
+//	assert.ThatError(t, fmt.Errorf("fetch: %w", context.Canceled)).IsContextCanceled()
+//
+//	// assertion will fail
+//	assert.ThatError(t, context.DeadlineExceeded).IsContextCanceled()
+func (a *ErrorAssert) IsContextCanceled() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.hasError() && !errors.Is(a.actual, context.Canceled) {
+		a.FailWithMessage("expected error to be a canceled context error (context.Canceled), but got %s", a.actual)
+	}
+	return a
+}
+
+// IsDeadlineExceeded verifies that the actual error reports an exceeded context deadline,
+// i.e. that it matches context.DeadlineExceeded.
+//
+//	// assertion will pass
+//	assert.ThatError(t, fmt.Errorf("fetch: %w", context.DeadlineExceeded)).IsDeadlineExceeded()
+//
+//	// assertion will fail
+//	assert.ThatError(t, context.Canceled).IsDeadlineExceeded()
+func (a *ErrorAssert) IsDeadlineExceeded() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.hasError() && !errors.Is(a.actual, context.DeadlineExceeded) {
+		a.FailWithMessage("expected error to be an exceeded deadline error (context.DeadlineExceeded), but got %s", a.actual)
+	}
+	return a
+}
+
+// IsAnyOf verifies that at least one of the given errors is in the actual error's chain.
+//
+//	// assertion will pass
+//	assert.ThatError(t, fmt.Errorf("fetch: %w", context.Canceled)).
+//	       IsAnyOf(context.Canceled, context.DeadlineExceeded)
+//
+//	// assertion will fail
+//	assert.ThatError(t, io.EOF).IsAnyOf(context.Canceled, context.DeadlineExceeded)
+func (a *ErrorAssert) IsAnyOf(errs ...error) *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.hasError() && !check.ErrorIsAnyOf(a.actual, errs) {
+		a.FailWithMessage("expected error to have any of %s in its error chain, but got %s", errs, a.actual)
+	}
+	return a
+}
+
+// Message verifies that the actual error is not nil and returns an assertion on its message,
+// sharing the description and representation of this assertion.
+//
+//	err := errors.New("open Config.yaml: permission denied")
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).
+//	       Message().
+//	       MatchesPattern(`^open \S+: `).
+//	       ContainsIgnoringCase("config")
+//
+//	// assertion will fail
+//	assert.ThatError(t, nil).Message()
+func (a *ErrorAssert) Message() *StringAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var message string
+	if a.hasError() {
+		message = a.actual.Error()
+	}
+	stringAssert := &StringAssert{actual: message}
+	stringAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, stringAssert)
+	stringAssert.inheritFailure(a.failed)
+	return stringAssert
+}
+
+// Unwrapped verifies that the actual error wraps another error.
+// The wrapped error becomes the new object under test, keeping the description and representation.
+//
+//	err := fmt.Errorf("open config: %w", fs.ErrPermission)
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).
+//	       Unwrapped().
+//	       HasMessage("permission denied")
+//
+//	// assertion will fail
+//	assert.ThatError(t, fs.ErrPermission).Unwrapped()
+func (a *ErrorAssert) Unwrapped() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var wrapped error
+	if a.hasError() {
+		if wrapped = errors.Unwrap(a.actual); wrapped == nil {
+			a.FailWithMessage("expected error to wrap another error, but got %s", a.actual)
+		}
+	}
+	return a.derive(wrapped)
+}
+
+// RootCause follows the chain of the actual error to the innermost error, which becomes the new object under test,
+// keeping the description and representation. The root cause of an error that does not wrap another one is the error itself.
+//
+//	err := fmt.Errorf("load settings: %w", fmt.Errorf("open config: %w", fs.ErrPermission))
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).
+//	       RootCause().
+//	       Is(fs.ErrPermission)
+func (a *ErrorAssert) RootCause() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var root error
+	if a.hasError() {
+		chain := check.ErrorChain(a.actual)
+		root = chain[len(chain)-1]
+	}
+	return a.derive(root)
+}
+
+// HasChainLength verifies that the chain of the actual error, including the error itself, has the given length.
+//
+//	err := fmt.Errorf("open config: %w", fs.ErrPermission)
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).HasChainLength(2)
+//
+//	// assertion will fail
+//	assert.ThatError(t, err).HasChainLength(3)
+func (a *ErrorAssert) HasChainLength(length int) *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if chain := check.ErrorChain(a.actual); len(chain) != length {
+		a.FailWithMessage("expected error to have a chain length of %s, but got %s"+a.formattedChain(), length, len(chain))
+	}
+	return a
+}
+
+// HasCauseWithMessage verifies that the error directly wrapped by the actual error has the given message.
+//
+//	err := fmt.Errorf("open config: %w", fs.ErrPermission)
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).HasCauseWithMessage("permission denied")
+//
+//	// assertion will fail
+//	assert.ThatError(t, err).HasCauseWithMessage("file does not exist")
+func (a *ErrorAssert) HasCauseWithMessage(message string) *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.hasError() {
+		return a
+	}
+	cause := errors.Unwrap(a.actual)
+	if cause == nil {
+		a.FailWithMessage("expected error to have a cause with message %s, but got %s without a cause", message, a.actual)
+	} else if !check.StringIsEqual(cause.Error(), message) {
+		a.FailWithMessage("expected error to have a cause with message %s, but got %s"+a.formattedChain(), message, cause.Error())
+	}
+	return a
+}
+
+// HasWrappedMessages verifies that the layers of the actual error chain add exactly the given messages, outermost first.
+// The message a layer adds is its message without the message of the error it wraps and the separating colon.
+//
+//	err := fmt.Errorf("load settings: %w", fmt.Errorf("open config: %w", fs.ErrPermission))
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).HasWrappedMessages("load settings", "open config", "permission denied")
+//
+//	// assertion will fail
+//	assert.ThatError(t, err).HasWrappedMessages("open config", "permission denied")
+func (a *ErrorAssert) HasWrappedMessages(messages ...string) *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.hasError() {
+		return a
+	}
+	if actual := check.ErrorOwnMessages(a.actual); !check.ObjectsAreEqual(messages, actual) {
+		a.FailWithMessage("expected error to have wrapped messages %s, but got %s"+a.formattedChain(), messages, actual)
+	}
+	return a
+}
+
+// ContainsError verifies that the actual error or any error it wraps, including each error combined
+// with errors.Join, matches the given error.
+//
+//	err := errors.Join(ErrNameRequired, fmt.Errorf("age: %w", ErrNegative))
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).ContainsError(ErrNegative)
+//
+//	// assertion will fail
+//	assert.ThatError(t, err).ContainsError(ErrTooLong)
+func (a *ErrorAssert) ContainsError(target error) *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.hasError() && !errors.Is(a.actual, target) {
+		a.FailWithMessage("expected error tree to contain %s, but it did not"+a.formattedTree(), target)
+	}
+	return a
+}
+
+// HasErrorCount verifies that the actual error has the given number of leaf errors, i.e. errors combined with
+// errors.Join where nested joined errors are flattened. An error that does not wrap a joined error has a single
+// leaf error. See LeafErrors for the leaf errors themselves.
+//
+//	err := errors.Join(ErrNameRequired, fmt.Errorf("age: %w", ErrNegative))
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).HasErrorCount(2)
+//
+//	// assertion will fail
+//	assert.ThatError(t, err).HasErrorCount(3)
+func (a *ErrorAssert) HasErrorCount(count int) *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.hasError() {
+		return a
+	}
+	if errs := check.ErrorLeafErrors(a.actual); len(errs) != count {
+		a.FailWithMessage("expected error to consist of %s errors, but got %s"+a.formattedTree(), count, len(errs))
+	}
+	return a
+}
+
+// HasExactlyErrorsMatching verifies that each leaf error of the actual error meets exactly one of the given
+// requirements and each requirement is met by exactly one leaf error, in any order.
+// The leaf errors are the ones counted by HasErrorCount.
+//
+//	err := errors.Join(ErrNameRequired, fmt.Errorf("age: %w", ErrNegative))
+//
+//	// assertion will pass
+//	assert.ThatError(t, err).HasExactlyErrorsMatching(
+//	       func(e *assert.ErrorAssert) { e.Is(ErrNegative).HasMessageStartingWith("age") },
+//	       func(e *assert.ErrorAssert) { e.Is(ErrNameRequired) },
+//	)
+func (a *ErrorAssert) HasExactlyErrorsMatching(requirements ...func(e *ErrorAssert)) *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.hasError() {
+		return a
+	}
+	errs := check.ErrorLeafErrors(a.actual)
+	if len(errs) != len(requirements) {
+		a.FailWithMessage("expected error to consist of %s errors matching the requirements, but got %s"+a.formattedTree(),
+			len(requirements), len(errs))
+		return a
+	}
+	matches := make([][]bool, len(requirements))
+	for i, requirement := range requirements {
+		matches[i] = make([]bool, len(errs))
+		for j, err := range errs {
+			matches[i][j] = meetsRequirement(func(c TestingT) { requirement(newErrorAssert(c, err)) })
+		}
+	}
+	if unmatched := check.SliceUnmatchedRequirements(matches, len(errs)); len(unmatched) > 0 {
+		a.FailWithMessage("expected errors to match the requirements in any order, but requirements at %s were not matched"+
+			a.formattedTree(), unmatched)
+	}
+	return a
+}
+
+// Errors returns an assertion on all errors in the tree of the actual error, keeping the description and
+// representation. The tree holds the actual error and every error it wraps, following both single and joined
+// wrapped errors, depth first with each error before the errors it wraps.
+//
+//	err := fmt.Errorf("validate: %w", errors.Join(ErrNameRequired, ErrNegative))
+//
+//	assert.ThatError(t, err).
+//	       Errors().
+//	       HasSize(4).
+//	       Contains(ErrNegative, ErrNameRequired)
+func (a *ErrorAssert) Errors() *SliceAssert[error] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.deriveErrors(check.ErrorTree)
+}
+
+// LeafErrors returns an assertion on the leaf errors of the actual error, keeping the description and representation.
+// The leaf errors are the ones counted by HasErrorCount.
+//
+//	err := errors.Join(ErrNameRequired, ErrNegative)
+//
+//	assert.ThatError(t, err).
+//	       LeafErrors().
+//	       ContainsExactlyInAnyOrder(ErrNegative, ErrNameRequired)
+func (a *ErrorAssert) LeafErrors() *SliceAssert[error] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return a.deriveErrors(check.ErrorLeafErrors)
+}
+
+// deriveErrors returns an assertion on the errors collected from the actual error, keeping the description,
+// representation and failure state. A nil actual error fails the assertion.
+func (a *ErrorAssert) deriveErrors(collect func(err error) []error) *SliceAssert[error] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var errs []error
+	if a.hasError() {
+		errs = collect(a.actual)
+	}
+	sliceAssert := &SliceAssert[error]{actual: errs}
+	sliceAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, sliceAssert)
+	sliceAssert.inheritFailure(a.failed)
+	return sliceAssert
+}
+
+// meetsRequirement runs the requirement against a TestingT that records failures and returns whether it passed.
+// The requirement runs synchronously, an assertion stopping it with FailNow is recovered.
+func meetsRequirement(requirement func(c TestingT)) (ok bool) {
+	recorder := new(requirementT)
+	defer func() {
+		if r := recover(); r != nil && r != errRequirementStopped {
+			panic(r)
+		}
+		ok = !recorder.failed
+	}()
+	requirement(recorder)
+	return
+}
+
+// errRequirementStopped is the panic value used by requirementT to stop a requirement.
+var errRequirementStopped = errors.New("requirement stopped")
+
+// requirementT is a TestingT that records whether a requirement of HasExactlyErrorsMatching failed.
+type requirementT struct {
+	failed bool
+}
+
+// Errorf records an assertion failure.
+func (r *requirementT) Errorf(string, ...any) {
+	r.failed = true
+}
+
+// Helper marks the calling function as a test helper.
+func (r *requirementT) Helper() {}
+
+// FailNow stops the requirement.
+func (r *requirementT) FailNow() {
+	r.failed = true
+	panic(errRequirementStopped)
+}
+
+// derive returns an assertion on another error that keeps the description, representation and failure state.
+func (a *ErrorAssert) derive(actual error) *ErrorAssert {
+	errorAssert := &ErrorAssert{actual: actual}
+	errorAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, errorAssert)
+	errorAssert.inheritFailure(a.failed)
+	return errorAssert
+}
+
+// formattedChain returns the chain of the actual error with one layer per line, to be appended to a failure message.
+func (a *ErrorAssert) formattedChain() string {
+	var b strings.Builder
+	b.WriteString("\nerror chain:")
+	for i, layer := range check.ErrorChain(a.actual) {
+		fmt.Fprintf(&b, "\n  [%d] %s", i, a.info.Representation()(layer.Error()))
+	}
+	return escapeFormat(b.String())
+}
+
+// formattedTree returns the tree of the actual error with one error per line, indented below the error wrapping it,
+// to be appended to a failure message. Each error is shown with the part of the message it adds to the wrapped errors,
+// or its whole message if it adds nothing.
+func (a *ErrorAssert) formattedTree() string {
+	var b strings.Builder
+	b.WriteString("\nerror tree:")
+	check.WalkErrorTree(a.actual, func(err error, depth int) bool {
+		children := check.ErrorChildren(err)
+		b.WriteString("\n" + strings.Repeat("  ", depth+1))
+		switch {
+		case check.ErrorIsJoined(err):
+			b.WriteString("joined:")
+		case len(children) == 1 && check.ErrorOwnMessage(err, children[0]) != "":
+			b.WriteString(a.info.Representation()(check.ErrorOwnMessage(err, children[0])))
+		default:
+			b.WriteString(a.info.Representation()(err.Error()))
+		}
+		return true
+	})
+	return escapeFormat(b.String())
+}
+
+// errorAs finds the first error in the chain of the actual error that matches type T
+// and returns an assertion on it, keeping the description and representation.
+func errorAs[T error](a *ErrorAssert) *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var target T
+	targetType := reflect.TypeOf(&target).Elem()
+	if a.hasError() && !errors.As(a.actual, &target) {
+		a.FailWithMessage("expected error to have an error of type %s in its error chain, but got %s", targetType, a.actual)
+	}
+	objectAssert := &ObjectAssert[T]{actual: target}
+	objectAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, objectAssert)
+	objectAssert.inheritFailure(a.failed)
+	return objectAssert
+}
+
+// hasError returns if the actual error is not nil, failing the assertion otherwise.
+func (a *ErrorAssert) hasError() bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual == nil {
+		a.FailWithMessage("expected an error, but got nil")
+		return false
+	}
+	return true
+}
@@ -1,8 +1,14 @@
 package assert_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"syscall"
 	"testing"
 
 	"github.com/skhome/assertg/assert"
@@ -161,3 +167,417 @@ func TestErrorHasMessageEndingWith(t *testing.T) {
 		return test.ok, fmt.Sprintf(messageFormat, test.message, test.err)
 	})
 }
+
+func TestErrorMessageAssertionsOnNilError(t *testing.T) {
+	assertions := map[string]func(a *assert.ErrorAssert){
+		"HasMessage":                func(a *assert.ErrorAssert) { a.HasMessage("file") },
+		"DoesNotHaveMessage":        func(a *assert.ErrorAssert) { a.DoesNotHaveMessage("file") },
+		"HasMessageContaining":      func(a *assert.ErrorAssert) { a.HasMessageContaining("file") },
+		"HasMessageContainingAnyOf": func(a *assert.ErrorAssert) { a.HasMessageContainingAnyOf("file") },
+		"HasMessageNotContaining":   func(a *assert.ErrorAssert) { a.HasMessageNotContaining("file") },
+		"HasMessageStartingWith":    func(a *assert.ErrorAssert) { a.HasMessageStartingWith("file") },
+		"HasMessageEndingWith":      func(a *assert.ErrorAssert) { a.HasMessageEndingWith("file") },
+	}
+	for name, assertion := range assertions {
+		t.Run(name, func(t *testing.T) {
+			fixture := new(fixtureT)
+			assertion(assert.ThatError(fixture, nil))
+			assertErrorMessage(t, fixture, "expected an error, but got nil")
+		})
+	}
+}
+
+func TestErrorChainStopsAfterFirstFailure(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, nil).
+		IsNotNil().
+		HasMessage("no such file").
+		HasMessageContaining("file")
+	assertErrorMessage(t, fixture, "expected error not to be nil, but got <nil>")
+}
+
+type validationError struct {
+	Field string
+}
+
+func (e *validationError) Error() string {
+	return "invalid " + e.Field
+}
+
+func TestErrorAs(t *testing.T) {
+	err := fmt.Errorf("create user: %w", &validationError{Field: "name"})
+
+	fixture := new(fixtureT)
+	assert.ErrorAs[*validationError](fixture, err).
+		Satisfies(func(e *validationError) bool { return e.Field == "name" }).
+		Extracting(func(e *validationError) any { return e.Field }).
+		IsEqualTo("name")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ErrorAs[*fs.PathError](fixture, err).
+		Extracting(func(e *fs.PathError) any { return e.Path }).
+		IsEqualTo("config.yaml")
+	assertErrorMessage(t, fixture, "expected error to have an error of type <*fs.PathError> in its error chain, but got <create user: invalid name>")
+
+	fixture = new(fixtureT)
+	assert.ErrorAs[*validationError](fixture, nil).Satisfies(func(e *validationError) bool { return e.Field == "name" })
+	assertErrorMessage(t, fixture, "expected an error, but got nil")
+
+	fixture = new(fixtureT)
+	assert.ErrorAs[*validationError](fixture, err).
+		DescribedAs("user").
+		Extracting(func(e *validationError) any { return e.Field }).
+		IsEqualTo("age")
+	assertErrorMessage(t, fixture, "expected value to equal <age>, but got <name>")
+}
+
+func TestErrorUnwrapped(t *testing.T) {
+	err := fmt.Errorf("open config: %w", fs.ErrPermission)
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).Unwrapped().Is(fs.ErrPermission).HasMessage("permission denied")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, fs.ErrPermission).Unwrapped().HasMessage("permission denied")
+	assertErrorMessage(t, fixture, "expected error to wrap another error, but got <permission denied>")
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).DescribedAs("config").Unwrapped().HasMessage("file does not exist")
+	assertErrorMessage(t, fixture, "[config] expected error to have message <file does not exist>, but got <permission denied>")
+}
+
+func TestErrorRootCause(t *testing.T) {
+	err := fmt.Errorf("load settings: %w", fmt.Errorf("open config: %w", fs.ErrPermission))
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).RootCause().Is(fs.ErrPermission).HasChainLength(1)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, fs.ErrPermission).RootCause().HasMessage("permission denied")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, nil).RootCause().HasMessage("permission denied")
+	assertErrorMessage(t, fixture, "expected an error, but got nil")
+}
+
+func TestErrorHasChainLength(t *testing.T) {
+	err := fmt.Errorf("load settings: %w", fmt.Errorf("open config: %w", fs.ErrPermission))
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).HasChainLength(3)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, nil).HasChainLength(0)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).HasChainLength(2)
+	assertErrorMessage(t, fixture, "expected error to have a chain length of <2>, but got <3>\n"+
+		"error chain:\n"+
+		"  [0] <load settings: open config: permission denied>\n"+
+		"  [1] <open config: permission denied>\n"+
+		"  [2] <permission denied>")
+}
+
+// cyclicError is an error that unwraps to the error set as its cause, which may be itself.
+type cyclicError struct {
+	cause error
+}
+
+func (e *cyclicError) Error() string { return "cyclic" }
+func (e *cyclicError) Unwrap() error { return e.cause }
+
+func TestErrorHasChainLengthWithCycles(t *testing.T) {
+	self := &cyclicError{}
+	self.cause = self
+	first, second := &cyclicError{}, &cyclicError{}
+	first.cause, second.cause = second, first
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, self).HasChainLength(1).RootCause().HasMessage("cyclic")
+	assert.ThatError(fixture, first).HasChainLength(2).HasErrorCount(1)
+	assertNoError(t, fixture)
+}
+
+// cyclicJoinError is a joined error that combines the errors set as its causes, which may include itself.
+type cyclicJoinError struct {
+	causes []error
+}
+
+func (e *cyclicJoinError) Error() string   { return "cyclic join" }
+func (e *cyclicJoinError) Unwrap() []error { return e.causes }
+
+func TestErrorTreeWithCycles(t *testing.T) {
+	self := &cyclicError{}
+	self.cause = self
+	joined := &cyclicJoinError{}
+	joined.causes = []error{errNameRequired, joined}
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, self).HasErrorCount(2)
+	assertErrorMessage(t, fixture, "expected error to consist of <2> errors, but got <1>\n"+
+		"error tree:\n"+
+		"  <cyclic>")
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, joined).HasErrorCount(2)
+	assertErrorMessage(t, fixture, "expected error to consist of <2> errors, but got <1>\n"+
+		"error tree:\n"+
+		"  joined:\n"+
+		"    <name is required>")
+}
+
+func TestErrorHasCauseWithMessage(t *testing.T) {
+	err := fmt.Errorf("open config: %w", fs.ErrPermission)
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).HasCauseWithMessage("permission denied")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).HasCauseWithMessage("file does not exist")
+	assertErrorMessage(t, fixture, "expected error to have a cause with message <file does not exist>, but got <permission denied>\n"+
+		"error chain:\n"+
+		"  [0] <open config: permission denied>\n"+
+		"  [1] <permission denied>")
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, fs.ErrPermission).HasCauseWithMessage("permission denied")
+	assertErrorMessage(t, fixture, "expected error to have a cause with message <permission denied>, but got <permission denied> without a cause")
+}
+
+func TestErrorHasWrappedMessages(t *testing.T) {
+	err := fmt.Errorf("load settings: %w", fmt.Errorf("open config: %w", fs.ErrPermission))
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).HasWrappedMessages("load settings", "open config", "permission denied")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, fmt.Errorf("%w (while opening config)", fs.ErrPermission)).
+		HasWrappedMessages("permission denied (while opening config)", "permission denied")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).HasWrappedMessages("open config", "permission denied")
+	assertErrorMessage(t, fixture, "expected error to have wrapped messages <[open config permission denied]>, "+
+		"but got <[load settings open config permission denied]>\n"+
+		"error chain:\n"+
+		"  [0] <load settings: open config: permission denied>")
+}
+
+var (
+	errNameRequired = errors.New("name is required")
+	errNegative     = errors.New("must not be negative")
+	errTooLong      = errors.New("too long")
+)
+
+func TestErrorContainsError(t *testing.T) {
+	err := fmt.Errorf("validate: %w", errors.Join(errNameRequired, fmt.Errorf("age: %w", errNegative)))
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).ContainsError(errNameRequired).ContainsError(errNegative)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).ContainsError(errTooLong)
+	assertErrorMessage(t, fixture, "expected error tree to contain <too long>, but it did not\n"+
+		"error tree:\n"+
+		"  <validate>\n"+
+		"    joined:\n"+
+		"      <name is required>\n"+
+		"      <age>\n"+
+		"        <must not be negative>")
+}
+
+func TestErrorHasErrorCount(t *testing.T) {
+	tests := []struct {
+		err   error
+		count int
+	}{
+		{err: errNameRequired, count: 1},
+		{err: fmt.Errorf("age: %w", errNegative), count: 1},
+		{err: errors.Join(errNameRequired, fmt.Errorf("age: %w", errNegative)), count: 2},
+		{err: fmt.Errorf("validate: %w", errors.Join(errNameRequired, errors.Join(errNegative, errTooLong))), count: 3},
+		{err: errors.Join(errNameRequired, fmt.Errorf("age: %w", errors.Join(errNegative, errTooLong))), count: 2},
+	}
+	for _, test := range tests {
+		fixture := new(fixtureT)
+		assert.ThatError(fixture, test.err).HasErrorCount(test.count)
+		assertNoError(t, fixture)
+
+		fixture = new(fixtureT)
+		assert.ThatError(fixture, test.err).HasErrorCount(test.count + 1)
+		assertErrorMessage(t, fixture, fmt.Sprintf("expected error to consist of <%d> errors, but got <%d>", test.count+1, test.count))
+	}
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, nil).HasErrorCount(0)
+	assertErrorMessage(t, fixture, "expected an error, but got nil")
+}
+
+func TestErrorHasExactlyErrorsMatching(t *testing.T) {
+	err := errors.Join(errNameRequired, fmt.Errorf("age: %w", errNegative))
+	isNegativeAge := func(e *assert.ErrorAssert) { e.Is(errNegative).HasMessageStartingWith("age") }
+	isNameRequired := func(e *assert.ErrorAssert) { e.Is(errNameRequired) }
+	isAnyError := func(e *assert.ErrorAssert) { e.IsNotNil() }
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).HasExactlyErrorsMatching(isNegativeAge, isNameRequired)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).HasExactlyErrorsMatching(isAnyError, isNameRequired)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).HasExactlyErrorsMatching(isNameRequired, isNameRequired)
+	assertErrorMessage(t, fixture, "expected errors to match the requirements in any order, but requirements at <[1]> were not matched\n"+
+		"error tree:\n"+
+		"  joined:\n"+
+		"    <name is required>\n"+
+		"    <age>\n"+
+		"      <must not be negative>")
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).HasExactlyErrorsMatching(isNameRequired)
+	assertErrorMessage(t, fixture, "expected error to consist of <1> errors matching the requirements, but got <2>")
+}
+
+func TestErrorErrors(t *testing.T) {
+	join := errors.Join(errNameRequired, errNegative)
+	err := fmt.Errorf("validate: %w", join)
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).Errors().ContainsExactly(err, join, errNameRequired, errNegative)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).DescribedAs("validation").Errors().Contains(errTooLong)
+	assertErrorMessage(t, fixture, "[validation] expected slice to contain")
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, nil).Errors().IsEmpty()
+	assertErrorMessage(t, fixture, "expected an error, but got nil")
+}
+
+func TestErrorLeafErrors(t *testing.T) {
+	err := errors.Join(errNameRequired, errNegative)
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).LeafErrors().ContainsExactlyInAnyOrder(errNegative, errNameRequired)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).DescribedAs("validation").LeafErrors().Contains(errTooLong)
+	assertErrorMessage(t, fixture, "[validation] expected slice to contain")
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, nil).LeafErrors().IsEmpty()
+	assertErrorMessage(t, fixture, "expected an error, but got nil")
+}
+
+func TestErrorMessage(t *testing.T) {
+	err := errors.New("open Config.yaml: permission denied")
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, err).
+		Message().
+		MatchesPattern(`^open \S+: `).
+		ContainsIgnoringCase("config").
+		IsEqualToIgnoringWhitespace("open Config.yaml:permission  denied")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, err).
+		DescribedAs("config error").
+		WithRepresentation(func(value any) string { return fmt.Sprintf("'%v'", value) }).
+		Message().
+		StartsWith("read")
+	assertErrorMessage(t, fixture, "[config error] expected string to start with 'read', but got 'open Config.yaml: permission denied'")
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, nil).Message().IsEmpty()
+	assertErrorMessage(t, fixture, "expected an error, but got nil")
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestErrorClassifications(t *testing.T) {
+	_, notExist := os.Open(filepath.Join(t.TempDir(), "missing.txt"))
+	cyclic := &cyclicError{}
+	cyclic.cause = cyclic
+	tests := []struct {
+		name    string
+		verify  func(a *assert.ErrorAssert) *assert.ErrorAssert
+		passing []error
+		failing []error
+		message string
+	}{
+		{"IsNotExist", (*assert.ErrorAssert).IsNotExist, []error{notExist, fs.ErrNotExist, syscall.ENOENT}, []error{fs.ErrPermission, io.EOF},
+			"expected error to be a not exist error (fs.ErrNotExist), but got <%v>"},
+		{"IsPermission", (*assert.ErrorAssert).IsPermission, []error{fs.ErrPermission, fmt.Errorf("open: %w", syscall.EACCES)}, []error{notExist},
+			"expected error to be a permission error (fs.ErrPermission), but got <%v>"},
+		{"IsTimeout", (*assert.ErrorAssert).IsTimeout, []error{context.DeadlineExceeded, os.ErrDeadlineExceeded, errors.Join(io.EOF, fmt.Errorf("read: %w", timeoutError{}))}, []error{context.Canceled, io.EOF, cyclic},
+			"expected error to be a timeout error, but got <%v>"},
+		{"IsTemporary", (*assert.ErrorAssert).IsTemporary, []error{syscall.EAGAIN, fmt.Errorf("read: %w", timeoutError{})}, []error{syscall.ENOENT, io.EOF, cyclic},
+			"expected error to be a temporary error, but got <%v>"},
+		{"IsContextCanceled", (*assert.ErrorAssert).IsContextCanceled, []error{context.Canceled, fmt.Errorf("fetch: %w", context.Canceled)}, []error{context.DeadlineExceeded},
+			"expected error to be a canceled context error (context.Canceled), but got <%v>"},
+		{"IsDeadlineExceeded", (*assert.ErrorAssert).IsDeadlineExceeded, []error{context.DeadlineExceeded, fmt.Errorf("fetch: %w", context.DeadlineExceeded)}, []error{context.Canceled, timeoutError{}},
+			"expected error to be an exceeded deadline error (context.DeadlineExceeded), but got <%v>"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, err := range test.passing {
+				fixture := new(fixtureT)
+				test.verify(assert.ThatError(fixture, err))
+				assertNoError(t, fixture)
+			}
+			for _, err := range test.failing {
+				fixture := new(fixtureT)
+				test.verify(assert.ThatError(fixture, err))
+				assertErrorMessage(t, fixture, fmt.Sprintf(test.message, err))
+			}
+			fixture := new(fixtureT)
+			test.verify(assert.ThatError(fixture, nil))
+			assertErrorMessage(t, fixture, "expected an error, but got nil")
+		})
+	}
+}
+
+func TestErrorHasErrno(t *testing.T) {
+	_, notExist := os.Open(filepath.Join(t.TempDir(), "missing.txt"))
+
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, notExist).HasErrno(syscall.ENOENT)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, fmt.Errorf("open: %w", syscall.ENOENT)).HasErrno(syscall.EACCES)
+	assertErrorMessage(t, fixture, fmt.Sprintf("expected error to have errno <%d> (<%v>), but got <open: %v> with errno <%d> (<%v>)",
+		uintptr(syscall.EACCES), syscall.EACCES, syscall.ENOENT, uintptr(syscall.ENOENT), syscall.ENOENT))
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, io.EOF).HasErrno(syscall.ENOENT)
+	assertErrorMessage(t, fixture, "but got <EOF> without an errno")
+}
+
+func TestErrorIsAnyOf(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatError(fixture, fmt.Errorf("fetch: %w", context.Canceled)).IsAnyOf(context.DeadlineExceeded, context.Canceled)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatError(fixture, io.EOF).IsAnyOf(context.DeadlineExceeded, context.Canceled)
+	assertErrorMessage(t, fixture, "expected error to have any of <[context deadline exceeded context canceled]> in its error chain, but got <EOF>")
+}
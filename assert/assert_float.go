@@ -1,14 +1,15 @@
 package assert
 
 import (
+	"cmp"
+
 	"github.com/skhome/assertg/check"
 	"golang.org/x/exp/constraints"
 )
 
 // FloatAssert provides asseetions on float values.
 type FloatAssert[T constraints.Float] struct {
-	*BaseAssert[FloatAssert[T]]
-	actual T
+	*OrderedBaseAssert[FloatAssert[T], T]
 }
 
 // newFloatAssert creates and returns a new FloatAssert.
@@ -16,44 +17,10 @@ func newFloatAssert[T constraints.Float](t TestingT, actual T) *FloatAssert[T] {
 	if h, ok := t.(tHelper); ok {
 		h.Helper()
 	}
-	integerAssert := &FloatAssert[T]{actual: actual}
-	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), integerAssert)
-	integerAssert.BaseAssert = baseAssert
-	return integerAssert
-}
-
-// IsEqualTo verifies that the actual value is equal to the given one.
-//
-//	// assertion will pass
-//	assert.ThatFloat(t, 1).IsEqualTo(1)
-//
-//	// assertion will fail
-//	assert.ThatFloat(t, 1).IsEqualTo(2)
-func (a *FloatAssert[T]) IsEqualTo(value T) *FloatAssert[T] {
-	if h, ok := a.t.(tHelper); ok {
-		h.Helper()
-	}
-	if !check.FloatsAreEqual(a.actual, value) {
-		a.FailWithMessage("expected value to equal %s, but got %s", value, a.actual)
-	}
-	return a
-}
-
-// IsNotEqualTo verifies that the actual value is not equal to the given one.
-//
-//	// assertion will pass
-//	assert.ThatFloat(t, 1).IsNotEqualTo(-1)
-//
-//	// assertion will fail
-//	assert.ThatFloat(t, 1).IsNotEqualTo(1)
-func (a *FloatAssert[T]) IsNotEqualTo(value T) *FloatAssert[T] {
-	if h, ok := a.t.(tHelper); ok {
-		h.Helper()
-	}
-	if check.FloatsAreEqual(a.actual, value) {
-		a.FailWithMessage("expected value not to equal %s, but got %s", value, a.actual)
-	}
-	return a
+	floatAssert := &FloatAssert[T]{}
+	floatAssert.OrderedBaseAssert = newOrderedBaseAssert(t, NewWritableAssertionInfo(), floatAssert, actual, cmp.Compare[T])
+	floatAssert.unordered = check.FloatIsNaN[T]
+	return floatAssert
 }
 
 // IsZero verifies that the actual value is zero.
@@ -158,95 +125,184 @@ func (a *FloatAssert[T]) IsNonNegative() *FloatAssert[T] {
 	return a
 }
 
-// IsLessThan verifies that the actual value is less then the given one.
+// IsCloseTo verifies that the actual value differs from the given one by at most the given tolerance,
+// either an absolute check.Offset or a check.Percentage of the given value.
+//
+//	// assertions will pass
+//	assert.ThatFloat(t, math.Sqrt(2)*math.Sqrt(2)).IsCloseTo(2, check.Offset(1e-9))
+//	assert.ThatFloat(t, 99.6).IsCloseTo(100, check.Percentage(0.5))
+//
+//	// assertion will fail
+//	assert.ThatFloat(t, 99).IsCloseTo(100, check.Percentage(0.5))
+func (a *FloatAssert[T]) IsCloseTo(expected T, tolerance check.Tolerance) *FloatAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.FloatIsCloseTo(a.actual, expected, tolerance) {
+		a.FailWithMessage("expected value to be close to %s within %s, but got %s with a difference of %s",
+			expected, tolerance, a.actual, a.actual-expected)
+	}
+	return a
+}
+
+// IsNotCloseTo verifies that the actual value differs from the given one by more than the given tolerance,
+// either an absolute check.Offset or a check.Percentage of the given value.
 //
 //	// assertion will pass
-//	assert.ThatFloat(t, 0).IsLessThan(1)
+//	assert.ThatFloat(t, 99).IsNotCloseTo(100, check.Percentage(0.5))
 //
 //	// assertion will fail
-//	assert.ThatFloat(t, 0).IsLessThan(0)
-func (a *FloatAssert[T]) IsLessThan(value T) *FloatAssert[T] {
+//	assert.ThatFloat(t, math.Sqrt(2)*math.Sqrt(2)).IsNotCloseTo(2, check.Offset(1e-9))
+func (a *FloatAssert[T]) IsNotCloseTo(expected T, tolerance check.Tolerance) *FloatAssert[T] {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-	if !check.FloatIsLessThan(a.actual, value) {
-		a.FailWithMessage("expected value to be less than %s, but got %s", value, a.actual)
+	if check.FloatIsCloseTo(a.actual, expected, tolerance) {
+		a.FailWithMessage("expected value not to be close to %s within %s, but got %s with a difference of %s",
+			expected, tolerance, a.actual, a.actual-expected)
 	}
 	return a
 }
 
-// IsLessThanOrEqualTo verifies that the actual value is less than or equal to the given one.
+// IsWithinULPs verifies that the actual value is at most the given number of representable float values
+// (units in the last place) away from the given one.
 //
 //	// assertion will pass
-//	assert.ThatFloat(t, 0).
-//	       IsLessThanOrEqualTo(1).
-//	       IsLessThanOrEqualTo(0)
+//	assert.ThatFloat(t, math.Sqrt(2)*math.Sqrt(2)).IsWithinULPs(2, 1)
 //
 //	// assertion will fail
-//	assert.ThatFloat(t, 1).IsLessThanOrEqualTo(0)
-func (a *FloatAssert[T]) IsLessThanOrEqualTo(value T) *FloatAssert[T] {
+//	assert.ThatFloat(t, math.Sqrt(2)*math.Sqrt(2)).IsWithinULPs(2, 0)
+func (a *FloatAssert[T]) IsWithinULPs(expected T, ulps uint64) *FloatAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.FloatIsWithinULPs(a.actual, expected, ulps) {
+		a.FailWithMessage("expected value to be within %s ULPs of %s, but got %s which is %s ULPs away",
+			ulps, expected, a.actual, check.FloatULPDistance(a.actual, expected))
+	}
+	return a
+}
+
+// IsNaN verifies that the actual value is not a number.
+//
+//	// assertion will pass
+//	assert.ThatFloat(t, math.NaN()).IsNaN()
+//
+//	// assertion will fail
+//	assert.ThatFloat(t, 1).IsNaN()
+func (a *FloatAssert[T]) IsNaN() *FloatAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.FloatIsNaN(a.actual) {
+		a.FailWithMessage("expected value to be NaN, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsNotNaN verifies that the actual value is a number.
+//
+//	// assertions will pass
+//	assert.ThatFloat(t, 1).IsNotNaN()
+//	assert.ThatFloat(t, math.Inf(1)).IsNotNaN()
+//
+//	// assertion will fail
+//	assert.ThatFloat(t, math.NaN()).IsNotNaN()
+func (a *FloatAssert[T]) IsNotNaN() *FloatAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.FloatIsNaN(a.actual) {
+		a.FailWithMessage("expected value not to be NaN, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsFinite verifies that the actual value is neither an infinity nor NaN.
+//
+//	// assertion will pass
+//	assert.ThatFloat(t, 1).IsFinite()
+//
+//	// assertions will fail
+//	assert.ThatFloat(t, math.Inf(-1)).IsFinite()
+//	assert.ThatFloat(t, math.NaN()).IsFinite()
+func (a *FloatAssert[T]) IsFinite() *FloatAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.FloatIsFinite(a.actual) {
+		a.FailWithMessage("expected value to be finite, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsInfinite verifies that the actual value is positive or negative infinity.
+//
+//	// assertions will pass
+//	assert.ThatFloat(t, math.Inf(1)).IsInfinite()
+//	assert.ThatFloat(t, math.Inf(-1)).IsInfinite()
+//
+//	// assertions will fail
+//	assert.ThatFloat(t, math.MaxFloat64).IsInfinite()
+//	assert.ThatFloat(t, math.NaN()).IsInfinite()
+func (a *FloatAssert[T]) IsInfinite() *FloatAssert[T] {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-	if !check.FloatIsLessThanOrEqualTo(a.actual, value) {
-		a.FailWithMessage("expected value to be less than or equal to %s, but got %s", value, a.actual)
+	if !check.FloatIsInf(a.actual, 0) {
+		a.FailWithMessage("expected value to be infinite, but got %s", a.actual)
 	}
 	return a
 }
 
-// IsGreaterThan verifies that the actual value is greater then the given one.
+// IsPositiveInfinity verifies that the actual value is positive infinity.
 //
 //	// assertion will pass
-//	assert.ThatFloat(t, 1).IsGreaterThan(0)
+//	assert.ThatFloat(t, math.Inf(1)).IsPositiveInfinity()
 //
 //	// assertion will fail
-//	assert.ThatFloat(t, 0).IsGreaterThan(0)
-func (a *FloatAssert[T]) IsGreaterThan(value T) *FloatAssert[T] {
+//	assert.ThatFloat(t, math.Inf(-1)).IsPositiveInfinity()
+func (a *FloatAssert[T]) IsPositiveInfinity() *FloatAssert[T] {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-	if !check.FloatIsGreaterThan(a.actual, value) {
-		a.FailWithMessage("expected value to be greater than %s, but got %s", value, a.actual)
+	if !check.FloatIsInf(a.actual, 1) {
+		a.FailWithMessage("expected value to be positive infinity, but got %s", a.actual)
 	}
 	return a
 }
 
-// IsGreaterThanOrEqualTo verifies that the actual value is greater than or equal to the given one.
+// IsNegativeInfinity verifies that the actual value is negative infinity.
 //
 //	// assertion will pass
-//	assert.ThatFloat(t, 1).
-//	       IsGreaterThanOrEqualTo(1).
-//	       IsGreaterThanOrEqualTo(0)
+//	assert.ThatFloat(t, math.Inf(-1)).IsNegativeInfinity()
 //
 //	// assertion will fail
-//	assert.ThatFloat(t, 0).IsGreaterThanOrEqualTo(1)
-func (a *FloatAssert[T]) IsGreaterThanOrEqualTo(value T) *FloatAssert[T] {
+//	assert.ThatFloat(t, math.Inf(1)).IsNegativeInfinity()
+func (a *FloatAssert[T]) IsNegativeInfinity() *FloatAssert[T] {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-	if !check.FloatIsGreaterThanOrEqualTo(a.actual, value) {
-		a.FailWithMessage("expected value to be greater than or equal to %s, but got %s", value, a.actual)
+	if !check.FloatIsInf(a.actual, -1) {
+		a.FailWithMessage("expected value to be negative infinity, but got %s", a.actual)
 	}
 	return a
 }
 
-// IsBetween verifies that the actual value is between the start and end value (inclusive).
+// IsNegativeZero verifies that the actual value is negative zero.
+// Negative zero is equal to zero, so it can only be told apart by its sign bit.
 //
 //	// assertion will pass
-//	assert.ThatFloat(t, 1).
-//	       IsBetween(0, 2).
-//	       IsBetween(1, 2).
-//	       IsBetween(0, 1)
+//	assert.ThatFloat(t, math.Copysign(0, -1)).IsNegativeZero()
 //
 //	// assertion will fail
-//	assert.ThatFloat(t, 1).
-//	       IsBetween(2, 3)
-func (a *FloatAssert[T]) IsBetween(startInclusive T, endInclusive T) *FloatAssert[T] {
+//	assert.ThatFloat(t, 0).IsNegativeZero()
+func (a *FloatAssert[T]) IsNegativeZero() *FloatAssert[T] {
 	if h, ok := a.t.(tHelper); ok {
 		h.Helper()
 	}
-	if !check.FloatIsBetween(a.actual, startInclusive, endInclusive) {
-		a.FailWithMessage("expected value to be between %s and %s, but got %s", startInclusive, endInclusive, a.actual)
+	if !check.FloatIsNegativeZero(a.actual) {
+		a.FailWithMessage("expected value to be negative zero, but got %s", a.actual)
 	}
 	return a
 }
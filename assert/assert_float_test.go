@@ -2,9 +2,11 @@ package assert_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/skhome/assertg/assert"
+	"github.com/skhome/assertg/check"
 	"golang.org/x/exp/constraints"
 )
 
@@ -182,3 +184,147 @@ func TestFloatIsBetween(t *testing.T) {
 		return test.ok, fmt.Sprintf(messageFormat, test.start, test.end, test.actual)
 	})
 }
+
+func TestFloatComparisonsWithNaN(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		name      string
+		condition func(a *assert.FloatAssert[float64])
+	}{
+		{"IsEqualTo", func(a *assert.FloatAssert[float64]) { a.IsEqualTo(nan) }},
+		{"IsLessThan", func(a *assert.FloatAssert[float64]) { a.IsLessThan(1) }},
+		{"IsGreaterThanOrEqualTo", func(a *assert.FloatAssert[float64]) { a.IsGreaterThanOrEqualTo(1) }},
+		{"IsBetween", func(a *assert.FloatAssert[float64]) { a.IsBetween(math.Inf(-1), math.Inf(1)) }},
+		{"IsStrictlyBetween", func(a *assert.FloatAssert[float64]) { a.IsStrictlyBetween(math.Inf(-1), math.Inf(1)) }},
+		{"IsIn", func(a *assert.FloatAssert[float64]) { a.IsIn(1, nan) }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := &fixtureT{}
+			test.condition(assert.ThatFloat(fixture, nan))
+			if fixture.message == "" {
+				t.Errorf("expected %s to fail for NaN", test.name)
+			}
+		})
+	}
+}
+
+type closeToTest struct {
+	actual    float64
+	other     float64
+	tolerance check.Tolerance
+	ok        bool
+}
+
+func TestFloatIsCloseTo(t *testing.T) {
+	tests := []closeToTest{
+		{actual: math.Sqrt(2) * math.Sqrt(2), other: 2, tolerance: check.Offset(1e-9), ok: true},
+		{actual: 99.5, other: 100, tolerance: check.Percentage(0.5), ok: true},
+		{actual: -99.5, other: -100, tolerance: check.Percentage(0.5), ok: true},
+		{actual: math.Inf(1), other: math.Inf(1), tolerance: check.Offset(0), ok: true},
+		{actual: 1.5, other: 1, tolerance: check.Offset(0.25), ok: false},
+		{actual: 99, other: 100, tolerance: check.Percentage(0.5), ok: false},
+		{actual: math.NaN(), other: 1, tolerance: check.Offset(1), ok: false},
+		{actual: math.Inf(1), other: 1, tolerance: check.Offset(1), ok: false},
+	}
+	messageFormat := "expected value to be close to <%v> within <%v>, but got <%v> with a difference of <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test closeToTest) (bool, string) {
+		assert.ThatFloat(fixture, test.actual).IsCloseTo(test.other, test.tolerance)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.tolerance, test.actual, test.actual-test.other)
+	})
+}
+
+func TestFloatIsCloseToMessage(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatFloat(fixture, float32(99)).IsCloseTo(100, check.Percentage(0.5))
+	assertErrorMessage(t, fixture, "expected value to be close to <100> within <0.5%>, but got <99> with a difference of <-1>")
+}
+
+func TestFloatIsNotCloseTo(t *testing.T) {
+	tests := []closeToTest{
+		{actual: 1.5, other: 1, tolerance: check.Offset(0.25), ok: true},
+		{actual: math.NaN(), other: 1, tolerance: check.Offset(1), ok: true},
+		{actual: math.Sqrt(2) * math.Sqrt(2), other: 2, tolerance: check.Offset(1e-9), ok: false},
+		{actual: 99.5, other: 100, tolerance: check.Percentage(0.5), ok: false},
+	}
+	messageFormat := "expected value not to be close to <%v> within <%v>, but got <%v> with a difference of <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test closeToTest) (bool, string) {
+		assert.ThatFloat(fixture, test.actual).IsNotCloseTo(test.other, test.tolerance)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.tolerance, test.actual, test.actual-test.other)
+	})
+}
+
+func TestFloatIsWithinULPs(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatFloat(fixture, math.Sqrt(2)*math.Sqrt(2)).IsWithinULPs(2, 1)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatFloat(fixture, math.Copysign(0, -1)).IsWithinULPs(0, 0)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatFloat(fixture, -math.SmallestNonzeroFloat64).IsWithinULPs(math.SmallestNonzeroFloat64, 2)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatFloat(fixture, math.Nextafter32(1, 2)).IsWithinULPs(1, 1)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatFloat(fixture, math.Sqrt(2)*math.Sqrt(2)).IsWithinULPs(2, 0)
+	assertErrorMessage(t, fixture, "expected value to be within <0> ULPs of <2>, but got <2.0000000000000004> which is <1> ULPs away")
+
+	fixture = new(fixtureT)
+	assert.ThatFloat(fixture, math.Nextafter32(math.Nextafter32(1, 2), 2)).IsWithinULPs(1, 1)
+	assertErrorMessage(t, fixture, "which is <2> ULPs away")
+
+	fixture = new(fixtureT)
+	assert.ThatFloat(fixture, math.NaN()).IsWithinULPs(math.NaN(), 100)
+	assertErrorMessage(t, fixture, "expected value to be within <100> ULPs of <NaN>, but got <NaN>")
+}
+
+func TestFloatSpecialValues(t *testing.T) {
+	negativeZero := math.Copysign(0, -1)
+	tests := []struct {
+		name    string
+		verify  func(a *assert.FloatAssert[float64]) *assert.FloatAssert[float64]
+		passing []float64
+		failing []float64
+		message string
+	}{
+		{"IsNaN", (*assert.FloatAssert[float64]).IsNaN, []float64{math.NaN()}, []float64{1, math.Inf(1)}, "expected value to be NaN, but got <%v>"},
+		{"IsNotNaN", (*assert.FloatAssert[float64]).IsNotNaN, []float64{1, math.Inf(1)}, []float64{math.NaN()}, "expected value not to be NaN, but got <%v>"},
+		{"IsFinite", (*assert.FloatAssert[float64]).IsFinite, []float64{0, math.MaxFloat64}, []float64{math.Inf(-1), math.NaN()}, "expected value to be finite, but got <%v>"},
+		{"IsInfinite", (*assert.FloatAssert[float64]).IsInfinite, []float64{math.Inf(1), math.Inf(-1)}, []float64{math.MaxFloat64, math.NaN()}, "expected value to be infinite, but got <%v>"},
+		{"IsPositiveInfinity", (*assert.FloatAssert[float64]).IsPositiveInfinity, []float64{math.Inf(1)}, []float64{math.Inf(-1), math.NaN()}, "expected value to be positive infinity, but got <%v>"},
+		{"IsNegativeInfinity", (*assert.FloatAssert[float64]).IsNegativeInfinity, []float64{math.Inf(-1)}, []float64{math.Inf(1), math.NaN()}, "expected value to be negative infinity, but got <%v>"},
+		{"IsNegativeZero", (*assert.FloatAssert[float64]).IsNegativeZero, []float64{negativeZero}, []float64{0, -1, math.NaN()}, "expected value to be negative zero, but got <%v>"},
+	}
+	for _, test := range tests {
+		for _, value := range test.passing {
+			fixture := new(fixtureT)
+			test.verify(assert.ThatFloat(fixture, value))
+			assertNoError(t, fixture)
+		}
+		for _, value := range test.failing {
+			fixture := new(fixtureT)
+			test.verify(assert.ThatFloat(fixture, value))
+			assertErrorMessage(t, fixture, fmt.Sprintf(test.message, value))
+		}
+	}
+}
+
+func TestFloatInFloatBits(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatFloat(fixture, 0.0).InFloatBits().IsNegativeZero()
+	assertErrorMessage(t, fixture, "expected value to be negative zero, but got <0x0000000000000000>")
+
+	fixture = new(fixtureT)
+	assert.ThatFloat(fixture, float32(1.5)).InFloatBits().IsEqualTo(-1.5)
+	assertErrorMessage(t, fixture, "expected value to equal <0xbfc00000>, but got <0x3fc00000>")
+
+	fixture = new(fixtureT)
+	assert.ThatFloat(fixture, math.Float64frombits(0x7ff8000000000001)).InFloatBits().IsNotNaN()
+	assertErrorMessage(t, fixture, "expected value not to be NaN, but got <0x7ff8000000000001>")
+}
@@ -0,0 +1,146 @@
+package assert
+
+import (
+	"github.com/skhome/assertg/check"
+	"golang.org/x/exp/constraints"
+)
+
+// FloatsAssert provides assertions on float slices, such as vectors of numerical results.
+type FloatsAssert[T constraints.Float] struct {
+	*BaseAssert[FloatsAssert[T]]
+	actual []T
+}
+
+// newFloatsAssert creates and returns a new FloatsAssert.
+func newFloatsAssert[S ~[]T, T constraints.Float](t TestingT, actual S) *FloatsAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	floatsAssert := &FloatsAssert[T]{actual: actual}
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), floatsAssert)
+	floatsAssert.BaseAssert = baseAssert
+	return floatsAssert
+}
+
+// HasSize verifies that the actual slice has the given number of elements.
+//
+//	// assertion will pass
+//	assert.ThatFloats(t, []float64{1, 2, 3}).HasSize(3)
+//
+//	// assertion will fail
+//	assert.ThatFloats(t, []float64{1, 2, 3}).HasSize(2)
+func (a *FloatsAssert[T]) HasSize(size int) *FloatsAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if len(a.actual) != size {
+		a.FailWithMessage("expected slice to have a size of %s, but got %s with a size of %s", size, a.actual, len(a.actual))
+	}
+	return a
+}
+
+// IsCloseTo verifies that each element of the actual slice differs from the element of the given slice
+// at the same index by at most the given tolerance. The failure reports the element with the largest difference.
+//
+//	// assertion will pass
+//	assert.ThatFloats(t, []float64{0.1 + 0.2, 1}).IsCloseTo([]float64{0.3, 1}, check.Offset(1e-9))
+//
+//	// assertions will fail
+//	assert.ThatFloats(t, []float64{0.3, 1.1}).IsCloseTo([]float64{0.3, 1}, check.Offset(1e-9))
+//	assert.ThatFloats(t, []float64{0.3}).IsCloseTo([]float64{0.3, 1}, check.Offset(1e-9))
+func (a *FloatsAssert[T]) IsCloseTo(expected []T, tolerance check.Tolerance) *FloatsAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if len(a.actual) != len(expected) {
+		a.FailWithMessage("expected slice to be close to %s within %s, but got %s with a different size of %s",
+			expected, tolerance, a.actual, len(a.actual))
+		return a
+	}
+	if index, count := check.FloatsWorstMismatch(a.actual, expected, tolerance); count > 0 {
+		a.FailWithMessage("expected slice to be close to %s within %s, but got %s with %s differing elements\n"+
+			"worst difference at index %s: expected %s, but got %s with a difference of %s",
+			expected, tolerance, a.actual, count, index, expected[index], a.actual[index], a.actual[index]-expected[index])
+	}
+	return a
+}
+
+// HasL2NormCloseTo verifies that the euclidean norm of the actual slice differs from the given norm
+// by at most the given tolerance.
+//
+//	// assertion will pass
+//	assert.ThatFloats(t, []float64{3, 4}).HasL2NormCloseTo(5, check.Offset(1e-9))
+//
+//	// assertion will fail
+//	assert.ThatFloats(t, []float64{3, 4}).HasL2NormCloseTo(7, check.Offset(1e-9))
+func (a *FloatsAssert[T]) HasL2NormCloseTo(norm float64, tolerance check.Tolerance) *FloatsAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if actualNorm := check.FloatsL2Norm(a.actual); !check.FloatIsCloseTo(actualNorm, norm, tolerance) {
+		a.FailWithMessage("expected slice to have an L2 norm close to %s within %s, but got %s with an L2 norm of %s",
+			norm, tolerance, a.actual, actualNorm)
+	}
+	return a
+}
+
+// IsMonotonic verifies that the elements of the actual slice are either non-decreasing or non-increasing.
+// NaN elements break the order.
+//
+//	// assertions will pass
+//	assert.ThatFloats(t, []float64{1, 1, 2, 3}).IsMonotonic()
+//	assert.ThatFloats(t, []float64{3, 2, 2, 1}).IsMonotonic()
+//
+//	// assertion will fail
+//	assert.ThatFloats(t, []float64{1, 3, 2}).IsMonotonic()
+func (a *FloatsAssert[T]) IsMonotonic() *FloatsAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	increasing := check.FloatsMonotonicityViolation(a.actual, true)
+	decreasing := check.FloatsMonotonicityViolation(a.actual, false)
+	if increasing >= 0 && decreasing >= 0 {
+		index := max(increasing, decreasing)
+		a.FailWithMessage("expected slice to be monotonic, but got %s with element %s at index %s breaking the order",
+			a.actual, a.actual[index], index)
+	}
+	return a
+}
+
+// IsNonDecreasing verifies that each element of the actual slice is greater than or equal to the previous one.
+// NaN elements break the order.
+//
+//	// assertion will pass
+//	assert.ThatFloats(t, []float64{1, 1, 2, 3}).IsNonDecreasing()
+//
+//	// assertion will fail
+//	assert.ThatFloats(t, []float64{1, 3, 2}).IsNonDecreasing()
+func (a *FloatsAssert[T]) IsNonDecreasing() *FloatsAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if index := check.FloatsMonotonicityViolation(a.actual, true); index >= 0 {
+		a.FailWithMessage("expected slice to be non-decreasing, but got %s with element %s at index %s breaking the order",
+			a.actual, a.actual[index], index)
+	}
+	return a
+}
+
+// IsNonIncreasing verifies that each element of the actual slice is less than or equal to the previous one.
+// NaN elements break the order.
+//
+//	// assertion will pass
+//	assert.ThatFloats(t, []float64{3, 2, 2, 1}).IsNonIncreasing()
+//
+//	// assertion will fail
+//	assert.ThatFloats(t, []float64{3, 1, 2}).IsNonIncreasing()
+func (a *FloatsAssert[T]) IsNonIncreasing() *FloatsAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if index := check.FloatsMonotonicityViolation(a.actual, false); index >= 0 {
+		a.FailWithMessage("expected slice to be non-increasing, but got %s with element %s at index %s breaking the order",
+			a.actual, a.actual[index], index)
+	}
+	return a
+}
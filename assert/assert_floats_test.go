@@ -0,0 +1,100 @@
+package assert_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skhome/assertg/assert"
+	"github.com/skhome/assertg/check"
+)
+
+func TestFloatsHasSize(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatFloats(fixture, []float64{1, 2, 3}).HasSize(3)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatFloats(fixture, []float64{1, 2, 3}).HasSize(2)
+	assertErrorMessage(t, fixture, "expected slice to have a size of <2>, but got <[1 2 3]> with a size of <3>")
+}
+
+func TestFloatsIsCloseTo(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatFloats(fixture, []float64{0.1 + 0.2, 1}).IsCloseTo([]float64{0.3, 1}, check.Offset(1e-9))
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatFloats(fixture, []float32{99.6, 201}).IsCloseTo([]float32{100, 200}, check.Percentage(0.5))
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatFloats(fixture, []float64{1.5, 2, 4}).IsCloseTo([]float64{1, 2, 3}, check.Offset(0.1))
+	assertErrorMessage(t, fixture, "expected slice to be close to <[1 2 3]> within <0.1>, but got <[1.5 2 4]> with <2> differing elements\n"+
+		"worst difference at index <2>: expected <3>, but got <4> with a difference of <1>")
+
+	fixture = new(fixtureT)
+	assert.ThatFloats(fixture, []float64{1, math.NaN(), 5}).IsCloseTo([]float64{1, 2, 3}, check.Offset(0.1))
+	assertErrorMessage(t, fixture, "worst difference at index <1>: expected <2>, but got <NaN>")
+
+	fixture = new(fixtureT)
+	assert.ThatFloats(fixture, []float64{1, 2}).IsCloseTo([]float64{1, 2, 3}, check.Offset(0.1))
+	assertErrorMessage(t, fixture, "expected slice to be close to <[1 2 3]> within <0.1>, but got <[1 2]> with a different size of <2>")
+}
+
+func TestFloatsHasL2NormCloseTo(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatFloats(fixture, []float64{3, 4}).HasL2NormCloseTo(5, check.Offset(1e-9))
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatFloats(fixture, []float64{}).HasL2NormCloseTo(0, check.Offset(0))
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatFloats(fixture, []float64{3, 4}).HasL2NormCloseTo(7, check.Offset(1e-9))
+	assertErrorMessage(t, fixture, "expected slice to have an L2 norm close to <7> within <1e-09>, but got <[3 4]> with an L2 norm of <5>")
+}
+
+func TestFloatsMonotonicity(t *testing.T) {
+	tests := []struct {
+		actual        []float64
+		monotonic     bool
+		nonDecreasing bool
+		nonIncreasing bool
+	}{
+		{actual: nil, monotonic: true, nonDecreasing: true, nonIncreasing: true},
+		{actual: []float64{1, 1, 2, 3}, monotonic: true, nonDecreasing: true},
+		{actual: []float64{3, 2, 2, 1}, monotonic: true, nonIncreasing: true},
+		{actual: []float64{1, 3, 2}},
+		{actual: []float64{1, math.NaN(), 2}},
+	}
+	for _, test := range tests {
+		fixture := new(fixtureT)
+		assert.ThatFloats(fixture, test.actual).IsMonotonic()
+		if test.monotonic {
+			assertNoError(t, fixture)
+		} else {
+			assertErrorMessage(t, fixture, "expected slice to be monotonic")
+		}
+
+		fixture = new(fixtureT)
+		assert.ThatFloats(fixture, test.actual).IsNonDecreasing()
+		if test.nonDecreasing {
+			assertNoError(t, fixture)
+		} else {
+			assertErrorMessage(t, fixture, "expected slice to be non-decreasing")
+		}
+
+		fixture = new(fixtureT)
+		assert.ThatFloats(fixture, test.actual).IsNonIncreasing()
+		if test.nonIncreasing {
+			assertNoError(t, fixture)
+		} else {
+			assertErrorMessage(t, fixture, "expected slice to be non-increasing")
+		}
+	}
+
+	fixture := new(fixtureT)
+	assert.ThatFloats(fixture, []float64{1, 3, 2}).IsMonotonic()
+	assertErrorMessage(t, fixture, "expected slice to be monotonic, but got <[1 3 2]> with element <2> at index <2> breaking the order")
+}
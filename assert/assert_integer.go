@@ -1,14 +1,15 @@
 package assert
 
 import (
+	"cmp"
+
 	"github.com/skhome/assertg/check"
 	"golang.org/x/exp/constraints"
 )
 
 // IntegerAssert provides asseetions on integer values.
 type IntegerAssert[T constraints.Integer] struct {
-	*BaseAssert[IntegerAssert[T]]
-	actual T
+	*OrderedBaseAssert[IntegerAssert[T], T]
 }
 
 // newIntegerAssert creates and returns a new IntegerAssert.
@@ -16,46 +17,11 @@ func newIntegerAssert[T constraints.Integer](t TestingT, actual T) *IntegerAsser
 	if h, ok := t.(tHelper); ok {
 		h.Helper()
 	}
-	integerAssert := &IntegerAssert[T]{actual: actual}
-	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), integerAssert)
-	integerAssert.BaseAssert = baseAssert
+	integerAssert := &IntegerAssert[T]{}
+	integerAssert.OrderedBaseAssert = newOrderedBaseAssert(t, NewWritableAssertionInfo(), integerAssert, actual, cmp.Compare[T])
 	return integerAssert
 }
 
-// IsEqualTo verifies that the actual value is equal to the given one.
-//
-//	// assertion will pass
-//	assert.ThatInteger(t, 1).IsEqualTo(1)
-//
-//	// assertion will fail
-//	assert.ThatInteger(t, 1).IsEqualTo(2)
-func (a *IntegerAssert[T]) IsEqualTo(value T) *IntegerAssert[T] {
-	if h, ok := a.t.(tHelper); ok {
-		h.Helper()
-	}
-	if !check.IntegersAreEqual(a.actual, value) {
-		a.FailWithMessage("expected value to equal %s, but got %s", value, a.actual)
-	}
-	return a
-}
-
-// IsNotEqualTo verifies that the actual value is not equal to the given one.
-//
-//	// assertion will pass
-//	assert.ThatInteger(t, 1).IsNotEqualTo(-1)
-//
-//	// assertion will fail
-//	assert.ThatInteger(t, 1).IsNotEqualTo(1)
-func (a *IntegerAssert[T]) IsNotEqualTo(value T) *IntegerAssert[T] {
-	if h, ok := a.t.(tHelper); ok {
-		h.Helper()
-	}
-	if check.IntegersAreEqual(a.actual, value) {
-		a.FailWithMessage("expected value not to equal %s, but got %s", value, a.actual)
-	}
-	return a
-}
-
 // IsZero verifies that the actual value is zero.
 //
 //	// assertion will pass
@@ -158,99 +124,6 @@ func (a *IntegerAssert[T]) IsNonNegative() *IntegerAssert[T] {
 	return a
 }
 
-// IsLessThan verifies that the actual value is less then the given one.
-//
-//	// assertion will pass
-//	assert.ThatInteger(t, 0).IsLessThan(1)
-//
-//	// assertion will fail
-//	assert.ThatInteger(t, 0).IsLessThan(0)
-func (a *IntegerAssert[T]) IsLessThan(value T) *IntegerAssert[T] {
-	if h, ok := a.t.(tHelper); ok {
-		h.Helper()
-	}
-	if !check.IntegerIsLessThan(a.actual, value) {
-		a.FailWithMessage("expected value to be less than %s, but got %s", value, a.actual)
-	}
-	return a
-}
-
-// IsLessThanOrEqualTo verifies that the actual value is less than or equal to the given one.
-//
-//	// assertion will pass
-//	assert.ThatInteger(t, 0).
-//	       IsLessThanOrEqualTo(1).
-//	       IsLessThanOrEqualTo(0)
-//
-//	// assertion will fail
-//	assert.ThatInteger(t, 1).IsLessThanOrEqualTo(0)
-func (a *IntegerAssert[T]) IsLessThanOrEqualTo(value T) *IntegerAssert[T] {
-	if h, ok := a.t.(tHelper); ok {
-		h.Helper()
-	}
-	if !check.IntegerIsLessThanOrEqualTo(a.actual, value) {
-		a.FailWithMessage("expected value to be less than or equal to %s, but got %s", value, a.actual)
-	}
-	return a
-}
-
-// IsGreaterThan verifies that the actual value is greater then the given one.
-//
-//	// assertion will pass
-//	assert.ThatInteger(t, 1).IsGreaterThan(0)
-//
-//	// assertion will fail
-//	assert.ThatInteger(t, 0).IsGreaterThan(0)
-func (a *IntegerAssert[T]) IsGreaterThan(value T) *IntegerAssert[T] {
-	if h, ok := a.t.(tHelper); ok {
-		h.Helper()
-	}
-	if !check.IntegerIsGreaterThan(a.actual, value) {
-		a.FailWithMessage("expected value to be greater than %s, but got %s", value, a.actual)
-	}
-	return a
-}
-
-// IsGreaterThanOrEqualTo verifies that the actual value is greater than or equal to the given one.
-//
-//	// assertion will pass
-//	assert.ThatInteger(t, 1).
-//	       IsGreaterThanOrEqualTo(1).
-//	       IsGreaterThanOrEqualTo(0)
-//
-//	// assertion will fail
-//	assert.ThatInteger(t, 0).IsGreaterThanOrEqualTo(1)
-func (a *IntegerAssert[T]) IsGreaterThanOrEqualTo(value T) *IntegerAssert[T] {
-	if h, ok := a.t.(tHelper); ok {
-		h.Helper()
-	}
-	if !check.IntegerIsGreaterThanOrEqualTo(a.actual, value) {
-		a.FailWithMessage("expected value to be greater than or equal to %s, but got %s", value, a.actual)
-	}
-	return a
-}
-
-// IsBetween verifies that the actual value is between the start and end value (inclusive).
-//
-//	// assertion will pass
-//	assert.ThatInteger(t, 1).
-//	       IsBetween(0, 2).
-//	       IsBetween(1, 2).
-//	       IsBetween(0, 1)
-//
-//	// assertion will fail
-//	assert.ThatInteger(t, 1).
-//	       IsBetween(2, 3)
-func (a *IntegerAssert[T]) IsBetween(startInclusive T, endInclusive T) *IntegerAssert[T] {
-	if h, ok := a.t.(tHelper); ok {
-		h.Helper()
-	}
-	if !check.IntegerIsBetween(a.actual, startInclusive, endInclusive) {
-		a.FailWithMessage("expected value to be between %s and %s, but got %s", startInclusive, endInclusive, a.actual)
-	}
-	return a
-}
-
 // IsEven verifies that the actual value is even.
 //
 //	// assertion will pass
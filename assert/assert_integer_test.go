@@ -182,6 +182,19 @@ func TestIntegerIsBetween(t *testing.T) {
 	})
 }
 
+func TestIntegerIsStrictlyBetween(t *testing.T) {
+	tests := []integerTest[int]{
+		{actual: 1, start: 0, end: 2, ok: true},
+		{actual: 1, start: 1, end: 2, ok: false},
+		{actual: 1, start: 0, end: 1, ok: false},
+	}
+	messageFormat := "expected value to be strictly between <%v> and <%v>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test integerTest[int]) (bool, string) {
+		assert.ThatInteger(fixture, test.actual).IsStrictlyBetween(test.start, test.end)
+		return test.ok, fmt.Sprintf(messageFormat, test.start, test.end, test.actual)
+	})
+}
+
 func TestIntegerIsEven(t *testing.T) {
 	tests := []integerTest[int]{
 		{actual: 0, ok: true},
@@ -0,0 +1,386 @@
+package assert
+
+import "github.com/skhome/assertg/check"
+
+// MapAssert provides assertions on maps.
+type MapAssert[K comparable, V any] struct {
+	*BaseAssert[MapAssert[K, V]]
+	actual map[K]V
+}
+
+// newMapAssert creates and returns a new MapAssert.
+func newMapAssert[M ~map[K]V, K comparable, V any](t TestingT, actual M) *MapAssert[K, V] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	mapAssert := &MapAssert[K, V]{actual: actual}
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), mapAssert)
+	mapAssert.BaseAssert = baseAssert
+	return mapAssert
+}
+
+// IsNil verifies that the actual map is nil.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int(nil)).IsNil()
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{}).IsNil()
+func (a *MapAssert[K, V]) IsNil() *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual != nil {
+		a.FailWithMessage("expected map to be nil, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsNotNil verifies that the actual map is not nil.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{}).IsNotNil()
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int(nil)).IsNotNil()
+func (a *MapAssert[K, V]) IsNotNil() *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual == nil {
+		a.FailWithMessage("expected map to not be nil, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsEmpty verifies that the actual map is nil or empty.
+//
+//	// assertions will pass
+//	assert.ThatMap(t, map[string]int(nil)).IsEmpty()
+//	assert.ThatMap(t, map[string]int{}).IsEmpty()
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).IsEmpty()
+func (a *MapAssert[K, V]) IsEmpty() *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.MapHasSize(a.actual, 0) {
+		a.FailWithMessage("expected map to be empty, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsNotEmpty verifies that the actual map is not empty.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).IsNotEmpty()
+//
+//	// assertions will fail
+//	assert.ThatMap(t, map[string]int(nil)).IsNotEmpty()
+//	assert.ThatMap(t, map[string]int{}).IsNotEmpty()
+func (a *MapAssert[K, V]) IsNotEmpty() *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.MapHasSize(a.actual, 0) {
+		a.FailWithMessage("expected map to not be empty, but got %s", a.actual)
+	}
+	return a
+}
+
+// HasSize verifies that the actual map has the given number of entries.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Sam": 38}).HasSize(2)
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).HasSize(2)
+func (a *MapAssert[K, V]) HasSize(size int) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.MapHasSize(a.actual, size) {
+		a.FailWithMessage("expected map to have a size of %s, but got %s", size, a.actual)
+	}
+	return a
+}
+
+// ContainsKey verifies that the actual map contains the given key.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).ContainsKey("Frodo")
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).ContainsKey("Sam")
+func (a *MapAssert[K, V]) ContainsKey(key K) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.MapContainsKey(a.actual, key) {
+		a.FailWithMessage("expected map to contain key %s, but got %s", key, a.actual)
+	}
+	return a
+}
+
+// ContainsKeys verifies that the actual map contains all given keys.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Sam": 38}).ContainsKeys("Frodo", "Sam")
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Sam": 38}).ContainsKeys("Frodo", "Merry")
+func (a *MapAssert[K, V]) ContainsKeys(keys ...K) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	for _, key := range keys {
+		if !check.MapContainsKey(a.actual, key) {
+			a.FailWithMessage("expected map to contain keys %s, but got %s", keys, a.actual)
+			break
+		}
+	}
+	return a
+}
+
+// DoesNotContainKey verifies that the actual map does not contain the given key.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).DoesNotContainKey("Sam")
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).DoesNotContainKey("Frodo")
+func (a *MapAssert[K, V]) DoesNotContainKey(key K) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.MapContainsKey(a.actual, key) {
+		a.FailWithMessage("expected map not to contain key %s, but got %s", key, a.actual)
+	}
+	return a
+}
+
+// DoesNotContainKeys verifies that the actual map contains none of the given keys.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).DoesNotContainKeys("Sam", "Merry")
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).DoesNotContainKeys("Sam", "Frodo")
+func (a *MapAssert[K, V]) DoesNotContainKeys(keys ...K) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	for _, key := range keys {
+		if check.MapContainsKey(a.actual, key) {
+			a.FailWithMessage("expected map not to contain keys %s, but got %s", keys, a.actual)
+			break
+		}
+	}
+	return a
+}
+
+// ContainsOnlyKeys verifies that the actual map contains all given keys and no other keys.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Sam": 38}).ContainsOnlyKeys("Sam", "Frodo")
+//
+//	// assertions will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Sam": 38}).ContainsOnlyKeys("Frodo")
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Sam": 38}).ContainsOnlyKeys("Frodo", "Sam", "Merry")
+func (a *MapAssert[K, V]) ContainsOnlyKeys(keys ...K) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	expected := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		expected[key] = struct{}{}
+	}
+	ok := len(expected) == len(a.actual)
+	for key := range expected {
+		if !check.MapContainsKey(a.actual, key) {
+			ok = false
+			break
+		}
+	}
+	if !ok {
+		a.FailWithMessage("expected map to contain only keys %s, but got %s", keys, a.actual)
+	}
+	return a
+}
+
+// ContainsEntry verifies that the actual map contains the given key with the given value.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).ContainsEntry("Frodo", 33)
+//
+//	// assertions will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).ContainsEntry("Frodo", 50)
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).ContainsEntry("Sam", 38)
+func (a *MapAssert[K, V]) ContainsEntry(key K, value V) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.MapContainsEntry(a.actual, key, value) {
+		a.FailWithMessage("expected map to contain entry %s: %s, but got %s", key, value, a.actual)
+	}
+	return a
+}
+
+// DoesNotContainEntry verifies that the actual map does not contain the given key with the given value.
+//
+//	// assertions will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).DoesNotContainEntry("Frodo", 50)
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).DoesNotContainEntry("Sam", 38)
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).DoesNotContainEntry("Frodo", 33)
+func (a *MapAssert[K, V]) DoesNotContainEntry(key K, value V) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.MapContainsEntry(a.actual, key, value) {
+		a.FailWithMessage("expected map not to contain entry %s: %s, but got %s", key, value, a.actual)
+	}
+	return a
+}
+
+// ContainsAllEntriesOf verifies that the actual map contains all entries of the given map.
+// The given map may be of any map type with the same key and value types, e.g. a named map type.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Sam": 38}).
+//	       ContainsAllEntriesOf(map[string]int{"Sam": 38})
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Sam": 38}).
+//	       ContainsAllEntriesOf(map[string]int{"Sam": 38, "Merry": 36})
+func (a *MapAssert[K, V]) ContainsAllEntriesOf(other map[K]V) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	for key, value := range other {
+		if !check.MapContainsEntry(a.actual, key, value) {
+			a.FailWithMessage("expected map to contain all entries of %s, but got %s", other, a.actual)
+			break
+		}
+	}
+	return a
+}
+
+// ContainsValue verifies that the actual map contains the given value for any key.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).ContainsValue(33)
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).ContainsValue(38)
+func (a *MapAssert[K, V]) ContainsValue(value V) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.MapContainsValue(a.actual, value) {
+		a.FailWithMessage("expected map to contain value %s, but got %s", value, a.actual)
+	}
+	return a
+}
+
+// DoesNotContainValue verifies that the actual map does not contain the given value for any key.
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).DoesNotContainValue(38)
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33}).DoesNotContainValue(33)
+func (a *MapAssert[K, V]) DoesNotContainValue(value V) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.MapContainsValue(a.actual, value) {
+		a.FailWithMessage("expected map not to contain value %s, but got %s", value, a.actual)
+	}
+	return a
+}
+
+// HasAllValues verifies that each value of the actual map matches the given predicate.
+//
+//	isAdult := func(age int) bool { return age >= 33 }
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Sam": 38}).HasAllValues(isAdult)
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Pippin": 28}).HasAllValues(isAdult)
+func (a *MapAssert[K, V]) HasAllValues(predicate check.Predicate[V]) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.MapMatchPredicateCount(a.actual, predicate) != len(a.actual) {
+		a.FailWithMessage("expected map to have all values match the predicate, but got %s", a.actual)
+	}
+	return a
+}
+
+// HasAnyValue verifies that any value of the actual map matches the given predicate.
+//
+//	isAdult := func(age int) bool { return age >= 33 }
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Pippin": 28}).HasAnyValue(isAdult)
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Pippin": 28}).HasAnyValue(isAdult)
+func (a *MapAssert[K, V]) HasAnyValue(predicate check.Predicate[V]) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.MapMatchPredicateCount(a.actual, predicate) == 0 {
+		a.FailWithMessage("expected map to have any value match the predicate, but got %s", a.actual)
+	}
+	return a
+}
+
+// HasNoValue verifies that no value of the actual map matches the given predicate.
+//
+//	isAdult := func(age int) bool { return age >= 33 }
+//
+//	// assertion will pass
+//	assert.ThatMap(t, map[string]int{"Pippin": 28}).HasNoValue(isAdult)
+//
+//	// assertion will fail
+//	assert.ThatMap(t, map[string]int{"Frodo": 33, "Pippin": 28}).HasNoValue(isAdult)
+func (a *MapAssert[K, V]) HasNoValue(predicate check.Predicate[V]) *MapAssert[K, V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.MapMatchPredicateCount(a.actual, predicate) != 0 {
+		a.FailWithMessage("expected map to have no value match the predicate, but got %s", a.actual)
+	}
+	return a
+}
+
+// ExtractingKeys extracts the sorted keys of the actual map.
+// The extracted slice becomes the new object under test.
+//
+//	assert.ThatMap(t, map[string]int{"Sam": 38, "Frodo": 33}).
+//	       ExtractingKeys().
+//	       ContainsExactly("Frodo", "Sam")
+func (a *MapAssert[K, V]) ExtractingKeys() *SliceAssert[K] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return ThatSlice(a.testingT(), check.MapSortedKeys(a.actual))
+}
+
+// ExtractingValues extracts the sorted values of the actual map.
+// The extracted slice becomes the new object under test.
+//
+//	assert.ThatMap(t, map[string]int{"Sam": 38, "Frodo": 33}).
+//	       ExtractingValues().
+//	       ContainsExactly(33, 38)
+func (a *MapAssert[K, V]) ExtractingValues() *SliceAssert[V] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return ThatSlice(a.testingT(), check.MapSortedValues(a.actual))
+}
@@ -0,0 +1,287 @@
+package assert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/skhome/assertg/assert"
+)
+
+type mapTest struct {
+	actual map[string]int
+	keys   []string
+	key    string
+	value  int
+	other  map[string]int
+	num    int
+	ok     bool
+}
+
+func isAdult(age int) bool { return age >= 33 }
+
+func TestMapIsNil(t *testing.T) {
+	tests := []mapTest{
+		{actual: nil, ok: true},
+		{actual: map[string]int{}, ok: false},
+	}
+	messageFormat := "expected map to be nil, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).IsNil()
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestMapIsNotNil(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{}, ok: true},
+		{actual: nil, ok: false},
+	}
+	messageFormat := "expected map to not be nil, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).IsNotNil()
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestMapIsEmpty(t *testing.T) {
+	tests := []mapTest{
+		{actual: nil, ok: true},
+		{actual: map[string]int{}, ok: true},
+		{actual: map[string]int{"Frodo": 33}, ok: false},
+	}
+	messageFormat := "expected map to be empty, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).IsEmpty()
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestMapIsNotEmpty(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33}, ok: true},
+		{actual: map[string]int{}, ok: false},
+		{actual: nil, ok: false},
+	}
+	messageFormat := "expected map to not be empty, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).IsNotEmpty()
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestMapHasSize(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, num: 2, ok: true},
+		{actual: nil, num: 0, ok: true},
+		{actual: map[string]int{"Frodo": 33}, num: 2, ok: false},
+	}
+	messageFormat := "expected map to have a size of <%d>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).HasSize(test.num)
+		return test.ok, fmt.Sprintf(messageFormat, test.num, test.actual)
+	})
+}
+
+func TestMapContainsKey(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33}, key: "Frodo", ok: true},
+		{actual: map[string]int{"Frodo": 33}, key: "Sam", ok: false},
+		{actual: nil, key: "Sam", ok: false},
+	}
+	messageFormat := "expected map to contain key <%s>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).ContainsKey(test.key)
+		return test.ok, fmt.Sprintf(messageFormat, test.key, test.actual)
+	})
+}
+
+func TestMapContainsKeys(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, keys: []string{"Frodo", "Sam"}, ok: true},
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, keys: []string{"Frodo", "Merry"}, ok: false},
+	}
+	messageFormat := "expected map to contain keys <%v>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).ContainsKeys(test.keys...)
+		return test.ok, fmt.Sprintf(messageFormat, test.keys, test.actual)
+	})
+}
+
+func TestMapDoesNotContainKey(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33}, key: "Sam", ok: true},
+		{actual: map[string]int{"Frodo": 33}, key: "Frodo", ok: false},
+	}
+	messageFormat := "expected map not to contain key <%s>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).DoesNotContainKey(test.key)
+		return test.ok, fmt.Sprintf(messageFormat, test.key, test.actual)
+	})
+}
+
+func TestMapDoesNotContainKeys(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33}, keys: []string{"Sam", "Merry"}, ok: true},
+		{actual: map[string]int{"Frodo": 33}, keys: []string{"Sam", "Frodo"}, ok: false},
+	}
+	messageFormat := "expected map not to contain keys <%v>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).DoesNotContainKeys(test.keys...)
+		return test.ok, fmt.Sprintf(messageFormat, test.keys, test.actual)
+	})
+}
+
+func TestMapContainsOnlyKeys(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, keys: []string{"Sam", "Frodo"}, ok: true},
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, keys: []string{"Sam", "Frodo", "Sam"}, ok: true},
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, keys: []string{"Frodo"}, ok: false},
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, keys: []string{"Frodo", "Sam", "Merry"}, ok: false},
+	}
+	messageFormat := "expected map to contain only keys <%v>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).ContainsOnlyKeys(test.keys...)
+		return test.ok, fmt.Sprintf(messageFormat, test.keys, test.actual)
+	})
+}
+
+func TestMapContainsEntry(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33}, key: "Frodo", value: 33, ok: true},
+		{actual: map[string]int{"Frodo": 33}, key: "Frodo", value: 50, ok: false},
+		{actual: map[string]int{"Frodo": 33}, key: "Sam", value: 0, ok: false},
+	}
+	messageFormat := "expected map to contain entry <%s>: <%d>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).ContainsEntry(test.key, test.value)
+		return test.ok, fmt.Sprintf(messageFormat, test.key, test.value, test.actual)
+	})
+}
+
+func TestMapDoesNotContainEntry(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33}, key: "Frodo", value: 50, ok: true},
+		{actual: map[string]int{"Frodo": 33}, key: "Frodo", value: 33, ok: false},
+	}
+	messageFormat := "expected map not to contain entry <%s>: <%d>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).DoesNotContainEntry(test.key, test.value)
+		return test.ok, fmt.Sprintf(messageFormat, test.key, test.value, test.actual)
+	})
+}
+
+func TestMapContainsAllEntriesOf(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, other: map[string]int{"Sam": 38}, ok: true},
+		{actual: map[string]int{"Frodo": 33}, other: map[string]int{}, ok: true},
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, other: map[string]int{"Sam": 38, "Merry": 36}, ok: false},
+	}
+	messageFormat := "expected map to contain all entries of <%v>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).ContainsAllEntriesOf(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
+
+type ages map[string]int
+
+func TestMapContainsAllEntriesOfNamedMap(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatMap(fixture, ages{"Frodo": 33, "Sam": 38}).ContainsAllEntriesOf(ages{"Sam": 38})
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatMap(fixture, map[string]int{"Frodo": 33}).ContainsAllEntriesOf(ages{"Sam": 38})
+	assertErrorMessage(t, fixture, "expected map to contain all entries of <map[Sam:38]>, but got <map[Frodo:33]>")
+}
+
+func TestMapContainsValue(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33}, value: 33, ok: true},
+		{actual: map[string]int{"Frodo": 33}, value: 38, ok: false},
+	}
+	messageFormat := "expected map to contain value <%d>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).ContainsValue(test.value)
+		return test.ok, fmt.Sprintf(messageFormat, test.value, test.actual)
+	})
+}
+
+func TestMapDoesNotContainValue(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33}, value: 38, ok: true},
+		{actual: map[string]int{"Frodo": 33}, value: 33, ok: false},
+	}
+	messageFormat := "expected map not to contain value <%d>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).DoesNotContainValue(test.value)
+		return test.ok, fmt.Sprintf(messageFormat, test.value, test.actual)
+	})
+}
+
+func TestMapHasAllValues(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33, "Sam": 38}, ok: true},
+		{actual: map[string]int{"Frodo": 33, "Pippin": 28}, ok: false},
+	}
+	messageFormat := "expected map to have all values match the predicate, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).HasAllValues(isAdult)
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestMapHasAnyValue(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Frodo": 33, "Pippin": 28}, ok: true},
+		{actual: map[string]int{"Pippin": 28}, ok: false},
+	}
+	messageFormat := "expected map to have any value match the predicate, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).HasAnyValue(isAdult)
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestMapHasNoValue(t *testing.T) {
+	tests := []mapTest{
+		{actual: map[string]int{"Pippin": 28}, ok: true},
+		{actual: map[string]int{"Frodo": 33, "Pippin": 28}, ok: false},
+	}
+	messageFormat := "expected map to have no value match the predicate, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test mapTest) (bool, string) {
+		assert.ThatMap(fixture, test.actual).HasNoValue(isAdult)
+		return test.ok, fmt.Sprintf(messageFormat, test.actual)
+	})
+}
+
+func TestMapExtractingKeys(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatMap(fixture, map[int]string{10: "ten", 9: "nine", 100: "hundred"}).
+		ExtractingKeys().
+		ContainsExactly(9, 10, 100)
+	assertNoError(t, fixture)
+}
+
+func TestMapExtractingPointerKeys(t *testing.T) {
+	sam, frodo, merry := &hobbit{"Sam", 38}, &hobbit{"Frodo", 33}, &hobbit{"Merry", 36}
+	fixture := new(fixtureT)
+	assert.ThatMap(fixture, map[*hobbit]bool{sam: true, frodo: true, merry: false}).
+		ExtractingKeys().
+		ContainsExactly(frodo, merry, sam)
+	assertNoError(t, fixture)
+}
+
+func TestMapExtractingValues(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatMap(fixture, map[string]int{"Sam": 38, "Frodo": 33, "Merry": 36}).
+		ExtractingValues().
+		ContainsExactly(33, 36, 38)
+	assertNoError(t, fixture)
+}
+
+func TestMapRepresentationIsSorted(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatMap(fixture, map[string]int{"Sam": 38, "Frodo": 33, "Merry": 36}).IsEmpty()
+	assertErrorMessage(t, fixture, "expected map to be empty, but got <map[Frodo:33 Merry:36 Sam:38]>")
+}
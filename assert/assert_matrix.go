@@ -0,0 +1,116 @@
+package assert
+
+import (
+	"github.com/skhome/assertg/check"
+	"golang.org/x/exp/constraints"
+)
+
+// MatrixAssert provides assertions on matrices of float values, given as slices of rows.
+type MatrixAssert[T constraints.Float] struct {
+	*BaseAssert[MatrixAssert[T]]
+	actual [][]T
+}
+
+// newMatrixAssert creates and returns a new MatrixAssert.
+func newMatrixAssert[M ~[][]T, T constraints.Float](t TestingT, actual M) *MatrixAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	matrixAssert := &MatrixAssert[T]{actual: actual}
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), matrixAssert)
+	matrixAssert.BaseAssert = baseAssert
+	return matrixAssert
+}
+
+// HasShape verifies that the actual matrix has the given number of rows and that each row has the given number of columns.
+//
+//	// assertion will pass
+//	assert.ThatMatrix(t, [][]float64{{1, 2, 3}, {4, 5, 6}}).HasShape(2, 3)
+//
+//	// assertions will fail
+//	assert.ThatMatrix(t, [][]float64{{1, 2, 3}, {4, 5, 6}}).HasShape(3, 2)
+//	assert.ThatMatrix(t, [][]float64{{1, 2, 3}, {4, 5}}).HasShape(2, 3)
+func (a *MatrixAssert[T]) HasShape(rows int, columns int) *MatrixAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	actualRows, actualColumns, rectangular := check.MatrixShape(a.actual)
+	if !rectangular {
+		a.FailWithMessage("expected matrix to have a shape of %sx%s, but got %s with rows of different lengths", rows, columns, a.actual)
+		return a
+	}
+	if actualRows != rows || (rows > 0 && actualColumns != columns) {
+		a.FailWithMessage("expected matrix to have a shape of %sx%s, but got %s with a shape of %sx%s",
+			rows, columns, a.actual, actualRows, actualColumns)
+	}
+	return a
+}
+
+// IsSymmetric verifies that the actual matrix is square and that each element differs from the element mirrored
+// at the diagonal by at most the given tolerance. The failure reports the number of differing pairs of mirrored
+// elements and the pair with the largest difference.
+//
+//	// assertion will pass
+//	assert.ThatMatrix(t, [][]float64{{1, 2}, {2, 1}}).IsSymmetric(check.Offset(1e-9))
+//
+//	// assertion will fail
+//	assert.ThatMatrix(t, [][]float64{{1, 2}, {3, 1}}).IsSymmetric(check.Offset(1e-9))
+func (a *MatrixAssert[T]) IsSymmetric(tolerance check.Tolerance) *MatrixAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	rows, columns, rectangular := check.MatrixShape(a.actual)
+	if !rectangular || rows != columns {
+		a.FailWithMessage("expected matrix to be symmetric within %s, but got %s which is not square", tolerance, a.actual)
+		return a
+	}
+	if row, column, count := check.MatrixAsymmetry(a.actual, tolerance); count > 0 {
+		a.FailWithMessage("expected matrix to be symmetric within %s, but got %s with %s differing pairs\n"+
+			"worst difference at [%s][%s]: %s differs from %s by %s",
+			tolerance, a.actual, count, row, column, a.actual[row][column], a.actual[column][row],
+			a.actual[row][column]-a.actual[column][row])
+	}
+	return a
+}
+
+// IsCloseTo verifies that the actual matrix has the same shape as the given one and that each element differs from
+// the element of the given matrix at the same position by at most the given tolerance.
+// The failure reports the element with the largest difference.
+//
+//	// assertion will pass
+//	assert.ThatMatrix(t, [][]float64{{0.1 + 0.2, 1}}).IsCloseTo([][]float64{{0.3, 1}}, check.Offset(1e-9))
+//
+//	// assertion will fail
+//	assert.ThatMatrix(t, [][]float64{{0.3, 1.1}}).IsCloseTo([][]float64{{0.3, 1}}, check.Offset(1e-9))
+func (a *MatrixAssert[T]) IsCloseTo(expected [][]T, tolerance check.Tolerance) *MatrixAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !haveSameShape(a.actual, expected) {
+		rows, columns, _ := check.MatrixShape(a.actual)
+		expectedRows, expectedColumns, _ := check.MatrixShape(expected)
+		a.FailWithMessage("expected matrix to be close to %s within %s, but got %s with a different shape of %sx%s instead of %sx%s",
+			expected, tolerance, a.actual, rows, columns, expectedRows, expectedColumns)
+		return a
+	}
+	if row, column, count := check.MatrixWorstMismatch(a.actual, expected, tolerance); count > 0 {
+		a.FailWithMessage("expected matrix to be close to %s within %s, but got %s with %s differing elements\n"+
+			"worst difference at [%s][%s]: expected %s, but got %s with a difference of %s",
+			expected, tolerance, a.actual, count, row, column, expected[row][column], a.actual[row][column],
+			a.actual[row][column]-expected[row][column])
+	}
+	return a
+}
+
+// haveSameShape returns whether both matrices have the same number of rows and each row has the same length.
+func haveSameShape[T any](actual, expected [][]T) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+	for i := range actual {
+		if len(actual[i]) != len(expected[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/skhome/assertg/assert"
+	"github.com/skhome/assertg/check"
+)
+
+func TestMatrixHasShape(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float64{{1, 2, 3}, {4, 5, 6}}).HasShape(2, 3)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float64{}).HasShape(0, 3)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float64{{1, 2, 3}, {4, 5, 6}}).HasShape(3, 2)
+	assertErrorMessage(t, fixture, "expected matrix to have a shape of <3>x<2>, but got <[[1 2 3] [4 5 6]]> with a shape of <2>x<3>")
+
+	fixture = new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float64{{1, 2, 3}, {4, 5}}).HasShape(2, 3)
+	assertErrorMessage(t, fixture, "expected matrix to have a shape of <2>x<3>, but got <[[1 2 3] [4 5]]> with rows of different lengths")
+}
+
+func TestMatrixIsSymmetric(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float64{{1, 2, 3}, {2, 1, 0.1 + 0.2}, {3, 0.3, 1}}).IsSymmetric(check.Offset(1e-9))
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float64{{1, 2, 3}, {2.5, 1, 0}, {6, 0, 1}}).IsSymmetric(check.Offset(1e-9))
+	assertErrorMessage(t, fixture, "with <2> differing pairs\nworst difference at [<0>][<2>]: <3> differs from <6> by <-3>")
+
+	fixture = new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float64{{1, 2}}).IsSymmetric(check.Offset(1e-9))
+	assertErrorMessage(t, fixture, "expected matrix to be symmetric within <1e-09>, but got <[[1 2]]> which is not square")
+}
+
+func TestMatrixIsCloseTo(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float32{{1, 2}, {3, 4.05}}).IsCloseTo([][]float32{{1, 2}, {3, 4}}, check.Offset(0.1))
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float64{{1, 2.5}, {3, 7}}).IsCloseTo([][]float64{{1, 2}, {3, 4}}, check.Offset(0.1))
+	assertErrorMessage(t, fixture, "expected matrix to be close to <[[1 2] [3 4]]> within <0.1>, but got <[[1 2.5] [3 7]]> with <2> differing elements\n"+
+		"worst difference at [<1>][<1>]: expected <4>, but got <7> with a difference of <3>")
+
+	fixture = new(fixtureT)
+	assert.ThatMatrix(fixture, [][]float64{{1, 2}}).IsCloseTo([][]float64{{1, 2}, {3, 4}}, check.Offset(0.1))
+	assertErrorMessage(t, fixture, "with a different shape of <1>x<2> instead of <2>x<2>")
+}
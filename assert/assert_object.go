@@ -0,0 +1,277 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/skhome/assertg/check"
+)
+
+// ObjectAssert provides assertions on arbitrary values.
+type ObjectAssert[T any] struct {
+	*BaseAssert[ObjectAssert[T]]
+	actual T
+}
+
+// newObjectAssert creates and returns a new ObjectAssert.
+func newObjectAssert[T any](t TestingT, actual T) *ObjectAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	objectAssert := &ObjectAssert[T]{actual: actual}
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), objectAssert)
+	objectAssert.BaseAssert = baseAssert
+	return objectAssert
+}
+
+// IsEqualTo verifies that the actual value is deeply equal to the given one.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, Hobbit{Name: "Frodo"}).IsEqualTo(Hobbit{Name: "Frodo"})
+//
+//	// assertion will fail
+//	assert.ThatObject(t, Hobbit{Name: "Frodo"}).IsEqualTo(Hobbit{Name: "Sam"})
+func (a *ObjectAssert[T]) IsEqualTo(expected T) *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.ObjectsAreEqual(expected, a.actual) {
+		a.FailWithMessage("expected value to equal %s, but got %s", expected, a.actual)
+	}
+	return a
+}
+
+// IsNotEqualTo verifies that the actual value is not deeply equal to the given one.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, Hobbit{Name: "Frodo"}).IsNotEqualTo(Hobbit{Name: "Sam"})
+//
+//	// assertion will fail
+//	assert.ThatObject(t, Hobbit{Name: "Frodo"}).IsNotEqualTo(Hobbit{Name: "Frodo"})
+func (a *ObjectAssert[T]) IsNotEqualTo(expected T) *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.ObjectsAreEqual(expected, a.actual) {
+		a.FailWithMessage("expected value not to equal %s, but got %s", expected, a.actual)
+	}
+	return a
+}
+
+// IsZero verifies that the actual value is the zero value of its type.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, Hobbit{}).IsZero()
+//
+//	// assertion will fail
+//	assert.ThatObject(t, Hobbit{Name: "Frodo"}).IsZero()
+func (a *ObjectAssert[T]) IsZero() *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.ObjectIsZero(a.actual) {
+		a.FailWithMessage("expected value to be zero, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsNotZero verifies that the actual value is not the zero value of its type.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, Hobbit{Name: "Frodo"}).IsNotZero()
+//
+//	// assertion will fail
+//	assert.ThatObject(t, Hobbit{}).IsNotZero()
+func (a *ObjectAssert[T]) IsNotZero() *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.ObjectIsZero(a.actual) {
+		a.FailWithMessage("expected value not to be zero, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsNil verifies that the actual value is nil. A nil pointer stored in an interface value is nil as well.
+//
+//	var frodo *Hobbit
+//
+//	// assertions will pass
+//	assert.ThatObject(t, frodo).IsNil()
+//	assert.ThatObject[any](t, frodo).IsNil()
+//
+//	// assertion will fail
+//	assert.ThatObject(t, &Hobbit{Name: "Frodo"}).IsNil()
+func (a *ObjectAssert[T]) IsNil() *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.ObjectIsNil(a.actual) {
+		a.FailWithMessage("expected value to be nil, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsNotNil verifies that the actual value is not nil. A nil pointer stored in an interface value is nil as well.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, &Hobbit{Name: "Frodo"}).IsNotNil()
+//
+//	// assertion will fail
+//	assert.ThatObject[any](t, (*Hobbit)(nil)).IsNotNil()
+func (a *ObjectAssert[T]) IsNotNil() *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.ObjectIsNil(a.actual) {
+		a.FailWithMessage("expected value not to be nil, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsIn verifies that the actual value is deeply equal to any of the given values.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, Hobbit{Name: "Sam"}).IsIn(Hobbit{Name: "Frodo"}, Hobbit{Name: "Sam"})
+//
+//	// assertion will fail
+//	assert.ThatObject(t, Hobbit{Name: "Merry"}).IsIn(Hobbit{Name: "Frodo"}, Hobbit{Name: "Sam"})
+func (a *ObjectAssert[T]) IsIn(values ...T) *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.ObjectIsIn(a.actual, values) {
+		a.FailWithMessage("expected value to be in %s, but got %s", values, a.actual)
+	}
+	return a
+}
+
+// IsNotIn verifies that the actual value is not deeply equal to any of the given values.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, Hobbit{Name: "Merry"}).IsNotIn(Hobbit{Name: "Frodo"}, Hobbit{Name: "Sam"})
+//
+//	// assertion will fail
+//	assert.ThatObject(t, Hobbit{Name: "Sam"}).IsNotIn(Hobbit{Name: "Frodo"}, Hobbit{Name: "Sam"})
+func (a *ObjectAssert[T]) IsNotIn(values ...T) *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.ObjectIsIn(a.actual, values) {
+		a.FailWithMessage("expected value not to be in %s, but got %s", values, a.actual)
+	}
+	return a
+}
+
+// IsSameAs verifies that the actual value refers to the same instance as the given one,
+// e.g. that two pointers point to the same address. Functions and values that are no references are never the same.
+//
+//	frodo := &Hobbit{Name: "Frodo"}
+//
+//	// assertion will pass
+//	assert.ThatObject(t, frodo).IsSameAs(frodo)
+//
+//	// assertion will fail
+//	assert.ThatObject(t, frodo).IsSameAs(&Hobbit{Name: "Frodo"})
+func (a *ObjectAssert[T]) IsSameAs(expected T) *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.ObjectsAreSame(a.actual, expected) {
+		a.FailWithMessage("expected value to be the same instance as %s, but got %s", expected, a.actual)
+	}
+	return a
+}
+
+// IsNotSameAs verifies that the actual value does not refer to the same instance as the given one.
+//
+//	frodo := &Hobbit{Name: "Frodo"}
+//
+//	// assertion will pass
+//	assert.ThatObject(t, frodo).IsNotSameAs(&Hobbit{Name: "Frodo"})
+//
+//	// assertion will fail
+//	assert.ThatObject(t, frodo).IsNotSameAs(frodo)
+func (a *ObjectAssert[T]) IsNotSameAs(expected T) *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.ObjectsAreSame(a.actual, expected) {
+		a.FailWithMessage("expected value not to be the same instance as %s, but got %s", expected, a.actual)
+	}
+	return a
+}
+
+// HasString verifies that the actual value implements fmt.Stringer and that its String method returns the given string.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, time.Second).HasString("1s")
+//
+//	// assertions will fail
+//	assert.ThatObject(t, time.Minute).HasString("1s")
+//	assert.ThatObject(t, Hobbit{Name: "Frodo"}).HasString("Frodo")
+func (a *ObjectAssert[T]) HasString(expected string) *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	stringer, ok := any(a.actual).(fmt.Stringer)
+	if !ok {
+		a.FailWithMessage("expected value to implement fmt.Stringer, but got %s of type %s", a.actual, reflect.TypeOf(a.actual))
+	} else if actual := stringer.String(); actual != expected {
+		a.FailWithMessage("expected value to have string %s, but got %s", expected, actual)
+	}
+	return a
+}
+
+// Satisfies verifies that the actual value matches the given predicate.
+//
+//	isAdult := func(h Hobbit) bool { return h.Age >= 33 }
+//
+//	// assertion will pass
+//	assert.ThatObject(t, Hobbit{Name: "Frodo", Age: 33}).Satisfies(isAdult)
+//
+//	// assertion will fail
+//	assert.ThatObject(t, Hobbit{Name: "Pippin", Age: 28}).Satisfies(isAdult)
+func (a *ObjectAssert[T]) Satisfies(predicate check.Predicate[T]) *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.failed && !predicate(a.actual) {
+		a.FailWithMessage("expected value to match the predicate, but got %s", a.actual)
+	}
+	return a
+}
+
+// Extracting extracts a value from the actual value using the given extractor function.
+// The extracted value becomes the new object under test.
+// The extractor is not called if an assertion of this chain has already failed.
+//
+//	assert.ErrorAs[*fs.PathError](t, err).
+//	       Extracting(func(e *fs.PathError) any { return e.Path }).
+//	       IsEqualTo("config.yaml")
+func (a *ObjectAssert[T]) Extracting(extractor func(value T) any) *ObjectAssert[any] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var extracted any
+	if !a.failed {
+		extracted = extractor(a.actual)
+	}
+	objectAssert := newObjectAssert(a.testingT(), extracted)
+	objectAssert.inheritFailure(a.failed)
+	return objectAssert
+}
+
+// UsingRecursiveComparison starts a field by field comparison of the actual value.
+// Description and representation of this assertion are kept.
+//
+//	assert.ThatObject(t, order).
+//	       UsingRecursiveComparison().
+//	       IgnoringFields("ID").
+//	       IsEqualTo(expectedOrder)
+func (a *ObjectAssert[T]) UsingRecursiveComparison() *RecursiveComparisonAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	return newRecursiveComparisonAssert(a.testingT(), a.info, a.actual)
+}
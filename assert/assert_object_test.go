@@ -0,0 +1,202 @@
+package assert_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+)
+
+type hobbit struct {
+	Name string
+	Age  int
+}
+
+type objectTest struct {
+	actual hobbit
+	other  hobbit
+	ok     bool
+}
+
+func TestObjectIsEqualTo(t *testing.T) {
+	tests := []objectTest{
+		{actual: hobbit{"Frodo", 33}, other: hobbit{"Frodo", 33}, ok: true},
+		{actual: hobbit{"Frodo", 33}, other: hobbit{"Sam", 38}, ok: false},
+	}
+	messageFormat := "expected value to equal <%v>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test objectTest) (bool, string) {
+		assert.ThatObject(fixture, test.actual).IsEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
+
+func TestObjectIsNotEqualTo(t *testing.T) {
+	tests := []objectTest{
+		{actual: hobbit{"Frodo", 33}, other: hobbit{"Sam", 38}, ok: true},
+		{actual: hobbit{"Frodo", 33}, other: hobbit{"Frodo", 33}, ok: false},
+	}
+	messageFormat := "expected value not to equal <%v>, but got <%v>"
+	runTests(t, tests)(func(fixture *fixtureT, test objectTest) (bool, string) {
+		assert.ThatObject(fixture, test.actual).IsNotEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, test.other, test.actual)
+	})
+}
+
+func TestObjectSatisfies(t *testing.T) {
+	isAdult := func(h hobbit) bool { return h.Age >= 33 }
+
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, hobbit{"Frodo", 33}).Satisfies(isAdult)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, hobbit{"Pippin", 28}).Satisfies(isAdult)
+	assertErrorMessage(t, fixture, "expected value to match the predicate, but got <{Pippin 28}>")
+}
+
+func TestObjectExtracting(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, hobbit{"Frodo", 33}).
+		Extracting(func(h hobbit) any { return h.Name }).
+		IsEqualTo("Frodo")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, hobbit{"Frodo", 33}).
+		Extracting(func(h hobbit) any { return h.Age }).
+		IsEqualTo(38)
+	assertErrorMessage(t, fixture, "expected value to equal <38>, but got <33>")
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, (*hobbit)(nil)).
+		IsNotEqualTo(nil).
+		Extracting(func(h *hobbit) any { return h.Name }).
+		IsEqualTo("Frodo")
+	assertErrorMessage(t, fixture, "expected value not to equal")
+}
+
+func TestObjectIsZero(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, hobbit{}).IsZero()
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, hobbit{"Frodo", 33}).IsZero()
+	assertErrorMessage(t, fixture, "expected value to be zero, but got <{Frodo 33}>")
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, hobbit{"Frodo", 33}).IsNotZero()
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatObject[any](fixture, nil).IsNotZero()
+	assertErrorMessage(t, fixture, "expected value not to be zero, but got <nil>")
+}
+
+func TestObjectIsNil(t *testing.T) {
+	var frodo *hobbit
+	tests := []struct {
+		actual any
+		isNil  bool
+	}{
+		{actual: nil, isNil: true},
+		{actual: frodo, isNil: true},
+		{actual: []int(nil), isNil: true},
+		{actual: map[string]int(nil), isNil: true},
+		{actual: &hobbit{"Frodo", 33}, isNil: false},
+		{actual: hobbit{}, isNil: false},
+		{actual: 0, isNil: false},
+	}
+	for _, test := range tests {
+		fixture := new(fixtureT)
+		assert.ThatObject(fixture, test.actual).IsNil()
+		if test.isNil {
+			assertNoError(t, fixture)
+		} else {
+			assertErrorMessage(t, fixture, "expected value to be nil")
+		}
+
+		fixture = new(fixtureT)
+		assert.ThatObject(fixture, test.actual).IsNotNil()
+		if test.isNil {
+			assertErrorMessage(t, fixture, "expected value not to be nil")
+		} else {
+			assertNoError(t, fixture)
+		}
+	}
+}
+
+func TestObjectIsIn(t *testing.T) {
+	frodo, sam, merry := hobbit{"Frodo", 33}, hobbit{"Sam", 38}, hobbit{"Merry", 36}
+
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, sam).IsIn(frodo, sam).IsNotIn(merry)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, merry).IsIn(frodo, sam)
+	assertErrorMessage(t, fixture, "expected value to be in <[{Frodo 33} {Sam 38}]>, but got <{Merry 36}>")
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, sam).IsNotIn(frodo, sam)
+	assertErrorMessage(t, fixture, "expected value not to be in <[{Frodo 33} {Sam 38}]>, but got <{Sam 38}>")
+}
+
+func TestObjectIsSameAs(t *testing.T) {
+	frodo := &hobbit{"Frodo", 33}
+	fellowship := []string{"Frodo", "Sam"}
+
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, frodo).IsSameAs(frodo).IsNotSameAs(&hobbit{"Frodo", 33})
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, fellowship).IsSameAs(fellowship).IsNotSameAs(fellowship[:1])
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, frodo).IsSameAs(&hobbit{"Frodo", 33})
+	assertErrorMessage(t, fixture, "expected value to be the same instance as <&{Frodo 33}>, but got <&{Frodo 33}>")
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, hobbit{"Frodo", 33}).IsSameAs(hobbit{"Frodo", 33})
+	assertErrorMessage(t, fixture, "expected value to be the same instance as <{Frodo 33}>")
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, frodo).IsNotSameAs(frodo)
+	assertErrorMessage(t, fixture, "expected value not to be the same instance as <&{Frodo 33}>, but got <&{Frodo 33}>")
+
+	counter := func(start int) func() int { return func() int { start++; return start } }
+	first, second := counter(0), counter(10)
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, first).IsNotSameAs(second).IsNotSameAs(first)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, first).IsSameAs(first)
+	assertErrorMessage(t, fixture, "expected value to be the same instance as")
+}
+
+func TestObjectHasString(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, time.Second).HasString("1s")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, time.Minute).HasString("1s")
+	assertErrorMessage(t, fixture, "expected value to have string <1s>, but got <1m0s>")
+
+	fixture = new(fixtureT)
+	assert.ThatObject(fixture, hobbit{"Frodo", 33}).HasString("Frodo")
+	assertErrorMessage(t, fixture, "expected value to implement fmt.Stringer, but got <{Frodo 33}> of type <assert_test.hobbit>")
+}
+
+func TestObjectDescribedAs(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, hobbit{"Frodo", 33}).
+		DescribedAs("ring bearer").
+		WithRepresentation(func(value any) string { return fmt.Sprintf("%+v", value) }).
+		IsZero()
+	assertErrorMessage(t, fixture, "[ring bearer] expected value to be zero, but got {Name:Frodo Age:33}")
+}
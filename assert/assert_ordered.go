@@ -0,0 +1,241 @@
+package assert
+
+import "github.com/skhome/assertg/check"
+
+// OrderedAssert provides assertions on values that are ordered by a comparator,
+// such as strings, named numeric types or any type with a custom comparison function.
+type OrderedAssert[T any] struct {
+	*OrderedBaseAssert[OrderedAssert[T], T]
+}
+
+// newOrderedAssert creates and returns a new OrderedAssert.
+func newOrderedAssert[T any](t TestingT, actual T, compare check.Comparator[T]) *OrderedAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	orderedAssert := &OrderedAssert[T]{}
+	orderedAssert.OrderedBaseAssert = newOrderedBaseAssert(t, NewWritableAssertionInfo(), orderedAssert, actual, compare)
+	return orderedAssert
+}
+
+// OrderedBaseAssert provides the comparison assertions shared by OrderedAssert, IntegerAssert and FloatAssert.
+// S is the type of the assertion returned for chaining, T the type of the compared values.
+type OrderedBaseAssert[S any, T any] struct {
+	*BaseAssert[S]
+	actual  T
+	compare check.Comparator[T]
+	// unordered reports values that are not ordered with any value, like NaN, if set.
+	unordered func(value T) bool
+}
+
+// newOrderedBaseAssert creates and returns a new OrderedBaseAssert for the given assertion.
+func newOrderedBaseAssert[S any, T any](t TestingT, info *WritableAssertionInfo, assertion *S, actual T,
+	compare check.Comparator[T]) *OrderedBaseAssert[S, T] {
+	return &OrderedBaseAssert[S, T]{BaseAssert: NewBaseAssert(t, info, assertion), actual: actual, compare: compare}
+}
+
+// IsEqualTo verifies that the actual value compares equal to the given one.
+//
+//	// assertion will pass
+//	assert.ThatOrdered(t, "frodo").IsEqualTo("frodo")
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, "frodo").IsEqualTo("sam")
+func (a *OrderedBaseAssert[S, T]) IsEqualTo(value T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if c, ok := a.compareTo(value); !ok || c != 0 {
+		a.FailWithMessage("expected value to equal %s, but got %s", value, a.actual)
+	}
+	return a.a
+}
+
+// IsNotEqualTo verifies that the actual value does not compare equal to the given one.
+//
+//	// assertion will pass
+//	assert.ThatOrdered(t, "frodo").IsNotEqualTo("sam")
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, "frodo").IsNotEqualTo("frodo")
+func (a *OrderedBaseAssert[S, T]) IsNotEqualTo(value T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if c, ok := a.compareTo(value); ok && c == 0 {
+		a.FailWithMessage("expected value not to equal %s, but got %s", value, a.actual)
+	}
+	return a.a
+}
+
+// IsLessThan verifies that the actual value is less than the given one.
+//
+//	// assertion will pass
+//	assert.ThatOrdered(t, "frodo").IsLessThan("sam")
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, "sam").IsLessThan("frodo")
+func (a *OrderedBaseAssert[S, T]) IsLessThan(value T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if c, ok := a.compareTo(value); !ok || c >= 0 {
+		a.FailWithMessage("expected value to be less than %s, but got %s", value, a.actual)
+	}
+	return a.a
+}
+
+// IsLessThanOrEqualTo verifies that the actual value is less than or equal to the given one.
+//
+//	// assertion will pass
+//	assert.ThatOrdered(t, "frodo").
+//	       IsLessThanOrEqualTo("sam").
+//	       IsLessThanOrEqualTo("frodo")
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, "sam").IsLessThanOrEqualTo("frodo")
+func (a *OrderedBaseAssert[S, T]) IsLessThanOrEqualTo(value T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if c, ok := a.compareTo(value); !ok || c > 0 {
+		a.FailWithMessage("expected value to be less than or equal to %s, but got %s", value, a.actual)
+	}
+	return a.a
+}
+
+// IsGreaterThan verifies that the actual value is greater than the given one.
+//
+//	// assertion will pass
+//	assert.ThatOrdered(t, "sam").IsGreaterThan("frodo")
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, "frodo").IsGreaterThan("sam")
+func (a *OrderedBaseAssert[S, T]) IsGreaterThan(value T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if c, ok := a.compareTo(value); !ok || c <= 0 {
+		a.FailWithMessage("expected value to be greater than %s, but got %s", value, a.actual)
+	}
+	return a.a
+}
+
+// IsGreaterThanOrEqualTo verifies that the actual value is greater than or equal to the given one.
+//
+//	// assertion will pass
+//	assert.ThatOrdered(t, "sam").
+//	       IsGreaterThanOrEqualTo("frodo").
+//	       IsGreaterThanOrEqualTo("sam")
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, "frodo").IsGreaterThanOrEqualTo("sam")
+func (a *OrderedBaseAssert[S, T]) IsGreaterThanOrEqualTo(value T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if c, ok := a.compareTo(value); !ok || c < 0 {
+		a.FailWithMessage("expected value to be greater than or equal to %s, but got %s", value, a.actual)
+	}
+	return a.a
+}
+
+// IsBetween verifies that the actual value is between the start and end value (inclusive).
+//
+//	// assertions will pass
+//	assert.ThatOrdered(t, "merry").
+//	       IsBetween("frodo", "sam").
+//	       IsBetween("merry", "sam")
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, "merry").IsBetween("pippin", "sam")
+func (a *OrderedBaseAssert[S, T]) IsBetween(startInclusive T, endInclusive T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.isOrdered(startInclusive, endInclusive) ||
+		!check.OrderedIsBetween(a.compare, a.actual, startInclusive, endInclusive) {
+		a.FailWithMessage("expected value to be between %s and %s, but got %s", startInclusive, endInclusive, a.actual)
+	}
+	return a.a
+}
+
+// IsStrictlyBetween verifies that the actual value is between the start and end value (exclusive).
+//
+//	// assertion will pass
+//	assert.ThatOrdered(t, "merry").IsStrictlyBetween("frodo", "sam")
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, "merry").IsStrictlyBetween("merry", "sam")
+func (a *OrderedBaseAssert[S, T]) IsStrictlyBetween(startExclusive T, endExclusive T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.isOrdered(startExclusive, endExclusive) ||
+		!check.OrderedIsStrictlyBetween(a.compare, a.actual, startExclusive, endExclusive) {
+		a.FailWithMessage("expected value to be strictly between %s and %s, but got %s", startExclusive, endExclusive, a.actual)
+	}
+	return a.a
+}
+
+// IsIn verifies that the actual value compares equal to any of the given values.
+//
+//	// assertion will pass
+//	assert.ThatOrdered(t, "sam").IsIn("frodo", "sam")
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, "merry").IsIn("frodo", "sam")
+func (a *OrderedBaseAssert[S, T]) IsIn(values ...T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.isOrdered() || !check.OrderedIsIn(a.compare, a.actual, values) {
+		a.FailWithMessage("expected value to be in %s, but got %s", values, a.actual)
+	}
+	return a.a
+}
+
+// IsClamped verifies that the actual value is the result of clamping the given value to the range from lower
+// to upper, i.e. the lower bound if the value is less, the upper bound if it is greater and the value itself otherwise.
+//
+//	// assertions will pass
+//	assert.ThatOrdered(t, min(max(120, 0), 100)).IsClamped(120, 0, 100)
+//	assert.ThatOrdered(t, min(max(42, 0), 100)).IsClamped(42, 0, 100)
+//
+//	// assertion will fail
+//	assert.ThatOrdered(t, 120).IsClamped(120, 0, 100)
+func (a *OrderedBaseAssert[S, T]) IsClamped(value T, lower T, upper T) *S {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if clamped := check.OrderedClamp(a.compare, value, lower, upper); !a.isOrdered(value, lower, upper) ||
+		a.compare(a.actual, clamped) != 0 {
+		a.FailWithMessage("expected value to be %s clamped between %s and %s, which is %s, but got %s",
+			value, lower, upper, clamped, a.actual)
+	}
+	return a.a
+}
+
+// isOrdered returns if the actual value and the given values are ordered, i.e. none of them is unordered like NaN.
+func (a *OrderedBaseAssert[S, T]) isOrdered(values ...T) bool {
+	if a.unordered == nil {
+		return true
+	}
+	if a.unordered(a.actual) {
+		return false
+	}
+	for _, value := range values {
+		if a.unordered(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// compareTo compares the actual value with the given one. It returns false if they are not ordered.
+func (a *OrderedBaseAssert[S, T]) compareTo(value T) (int, bool) {
+	if !a.isOrdered(value) {
+		return 0, false
+	}
+	return a.compare(a.actual, value), true
+}
@@ -0,0 +1,116 @@
+package assert_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+)
+
+type priority uint8
+
+func (p priority) String() string {
+	return [...]string{"low", "medium", "high"}[p]
+}
+
+func TestOrderedComparisons(t *testing.T) {
+	tests := []struct {
+		name    string
+		verify  func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string]
+		ok      bool
+		message string
+	}{
+		{"IsEqualTo", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsEqualTo("merry") }, true, ""},
+		{"IsEqualTo", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsEqualTo("sam") }, false, "expected value to equal <sam>, but got <merry>"},
+		{"IsNotEqualTo", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsNotEqualTo("sam") }, true, ""},
+		{"IsNotEqualTo", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsNotEqualTo("merry") }, false, "expected value not to equal <merry>, but got <merry>"},
+		{"IsLessThan", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsLessThan("sam") }, true, ""},
+		{"IsLessThan", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsLessThan("merry") }, false, "expected value to be less than <merry>, but got <merry>"},
+		{"IsLessThanOrEqualTo", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] {
+			return a.IsLessThanOrEqualTo("merry")
+		}, true, ""},
+		{"IsLessThanOrEqualTo", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] {
+			return a.IsLessThanOrEqualTo("frodo")
+		}, false, "expected value to be less than or equal to <frodo>, but got <merry>"},
+		{"IsGreaterThan", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsGreaterThan("frodo") }, true, ""},
+		{"IsGreaterThan", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsGreaterThan("merry") }, false, "expected value to be greater than <merry>, but got <merry>"},
+		{"IsGreaterThanOrEqualTo", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] {
+			return a.IsGreaterThanOrEqualTo("merry")
+		}, true, ""},
+		{"IsGreaterThanOrEqualTo", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] {
+			return a.IsGreaterThanOrEqualTo("sam")
+		}, false, "expected value to be greater than or equal to <sam>, but got <merry>"},
+		{"IsBetween", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] {
+			return a.IsBetween("merry", "sam")
+		}, true, ""},
+		{"IsBetween", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] {
+			return a.IsBetween("pippin", "sam")
+		}, false, "expected value to be between <pippin> and <sam>, but got <merry>"},
+		{"IsStrictlyBetween", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] {
+			return a.IsStrictlyBetween("frodo", "sam")
+		}, true, ""},
+		{"IsStrictlyBetween", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] {
+			return a.IsStrictlyBetween("merry", "sam")
+		}, false, "expected value to be strictly between <merry> and <sam>, but got <merry>"},
+		{"IsIn", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsIn("frodo", "merry") }, true, ""},
+		{"IsIn", func(a *assert.OrderedAssert[string]) *assert.OrderedAssert[string] { return a.IsIn("frodo", "sam") }, false, "expected value to be in <[frodo sam]>, but got <merry>"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := new(fixtureT)
+			test.verify(assert.ThatOrdered(fixture, "merry"))
+			if test.ok {
+				assertNoError(t, fixture)
+			} else {
+				assertErrorMessage(t, fixture, test.message)
+			}
+		})
+	}
+}
+
+type clampTest struct {
+	actual int
+	value  int
+	ok     bool
+}
+
+func TestOrderedIsClamped(t *testing.T) {
+	tests := []clampTest{
+		{actual: 0, value: -5, ok: true},
+		{actual: 42, value: 42, ok: true},
+		{actual: 100, value: 120, ok: true},
+		{actual: 120, value: 120, ok: false},
+	}
+	messageFormat := "expected value to be <%d> clamped between <0> and <100>, which is <100>, but got <%d>"
+	runTests(t, tests)(func(fixture *fixtureT, test clampTest) (bool, string) {
+		assert.ThatOrdered(fixture, test.actual).IsClamped(test.value, 0, 100)
+		return test.ok, fmt.Sprintf(messageFormat, test.value, test.actual)
+	})
+}
+
+func TestOrderedNamedTypes(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatOrdered(fixture, priority(1)).IsBetween(0, 2).IsGreaterThan(0)
+	assert.ThatOrdered(fixture, time.Minute).IsStrictlyBetween(time.Second, time.Hour)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatOrdered(fixture, priority(1)).IsGreaterThan(2)
+	assertErrorMessage(t, fixture, "expected value to be greater than <high>, but got <medium>")
+}
+
+func TestComparable(t *testing.T) {
+	byLength := func(a, b string) int { return len(a) - len(b) }
+	ignoringCase := func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) }
+
+	fixture := new(fixtureT)
+	assert.ThatComparable(fixture, "Sam", byLength).IsLessThan("Frodo").IsIn("Tom", "Bob")
+	assert.ThatComparable(fixture, "Frodo", ignoringCase).IsEqualTo("FRODO")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatComparable(fixture, "Frodo", byLength).IsLessThan("Sam")
+	assertErrorMessage(t, fixture, "expected value to be less than <Sam>, but got <Frodo>")
+}
@@ -0,0 +1,190 @@
+package assert
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/skhome/assertg/check"
+)
+
+// PointerAssert provides assertions on pointers, e.g. optional fields.
+type PointerAssert[T any] struct {
+	*BaseAssert[PointerAssert[T]]
+	actual *T
+}
+
+// newPointerAssert creates and returns a new PointerAssert.
+func newPointerAssert[T any](t TestingT, actual *T) *PointerAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	pointerAssert := &PointerAssert[T]{actual: actual}
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), pointerAssert)
+	pointerAssert.BaseAssert = baseAssert
+	return pointerAssert
+}
+
+// IsNil verifies that the actual pointer is nil.
+//
+//	// assertion will pass
+//	assert.ThatPointer(t, (*string)(nil)).IsNil()
+//
+//	// assertion will fail
+//	assert.ThatPointer(t, &name).IsNil()
+func (a *PointerAssert[T]) IsNil() *PointerAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual != nil {
+		a.FailWithMessage("expected pointer to be nil, but it points to %s", *a.actual)
+	}
+	return a
+}
+
+// IsNotNil verifies that the actual pointer is not nil.
+//
+//	// assertion will pass
+//	assert.ThatPointer(t, &name).IsNotNil()
+//
+//	// assertion will fail
+//	assert.ThatPointer(t, (*string)(nil)).IsNotNil()
+func (a *PointerAssert[T]) IsNotNil() *PointerAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual == nil {
+		a.FailWithMessage("expected pointer not to be nil, but got nil")
+	}
+	return a
+}
+
+// PointsTo verifies that the actual pointer is not nil and points to a value deeply equal to the given one.
+//
+//	name := "Frodo"
+//
+//	// assertion will pass
+//	assert.ThatPointer(t, &name).PointsTo("Frodo")
+//
+//	// assertions will fail
+//	assert.ThatPointer(t, &name).PointsTo("Sam")
+//	assert.ThatPointer(t, (*string)(nil)).PointsTo("Frodo")
+func (a *PointerAssert[T]) PointsTo(expected T) *PointerAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual == nil {
+		a.FailWithMessage("expected pointer to point to %s, but got nil", expected)
+	} else if !check.ObjectsAreEqual(expected, *a.actual) {
+		a.FailWithMessage("expected pointer to point to %s, but it points to %s", expected, *a.actual)
+	}
+	return a
+}
+
+// IsSameAs verifies that the actual pointer points to the same address as the given one.
+//
+//	frodo := &Hobbit{Name: "Frodo"}
+//
+//	// assertion will pass
+//	assert.ThatPointer(t, frodo).IsSameAs(frodo)
+//
+//	// assertion will fail
+//	assert.ThatPointer(t, frodo).IsSameAs(&Hobbit{Name: "Frodo"})
+func (a *PointerAssert[T]) IsSameAs(other *T) *PointerAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual != other {
+		a.FailWithMessage("expected pointer to be the same as %s, but got %s", addressOf(other), addressOf(a.actual))
+	}
+	return a
+}
+
+// Value verifies that the actual pointer is not nil and returns an assertion on the value it points to,
+// keeping the description and representation.
+//
+//	assert.ThatPointer(t, dto.Owner).
+//	       Value().
+//	       IsEqualTo(Hobbit{Name: "Frodo"})
+func (a *PointerAssert[T]) Value() *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var value T
+	if a.hasValue() {
+		value = *a.actual
+	}
+	objectAssert := &ObjectAssert[T]{actual: value}
+	objectAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, objectAssert)
+	objectAssert.inheritFailure(a.failed)
+	return objectAssert
+}
+
+// ValueAsString verifies that the actual pointer is not nil and points to a string,
+// and returns an assertion on that string, keeping the description and representation.
+//
+//	assert.ThatPointer(t, dto.Nickname).
+//	       ValueAsString().
+//	       StartsWith("Fro")
+func (a *PointerAssert[T]) ValueAsString() *StringAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var value string
+	if a.hasValue() {
+		if v := reflect.ValueOf(a.actual).Elem(); v.Kind() == reflect.String {
+			value = v.String()
+		} else {
+			a.FailWithMessage("expected pointer to point to a string, but it points to %s of type %s", *a.actual, v.Type())
+		}
+	}
+	stringAssert := &StringAssert{actual: value}
+	stringAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, stringAssert)
+	stringAssert.inheritFailure(a.failed)
+	return stringAssert
+}
+
+// ValueAsInteger verifies that the actual pointer is not nil and points to an integer that fits into an int64,
+// and returns an assertion on that integer, keeping the description and representation.
+//
+//	assert.ThatPointer(t, dto.Age).
+//	       ValueAsInteger().
+//	       IsBetween(18, 120)
+func (a *PointerAssert[T]) ValueAsInteger() *IntegerAssert[int64] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var value int64
+	if a.hasValue() {
+		switch v := reflect.ValueOf(a.actual).Elem(); {
+		case v.CanInt():
+			value = v.Int()
+		case v.CanUint() && v.Uint() <= math.MaxInt64:
+			value = int64(v.Uint())
+		default:
+			a.FailWithMessage("expected pointer to point to an integer, but it points to %s of type %s", *a.actual, v.Type())
+		}
+	}
+	integerAssert := &IntegerAssert[int64]{}
+	integerAssert.OrderedBaseAssert = newOrderedBaseAssert(a.testingT(), a.info, integerAssert, value, cmp.Compare[int64])
+	integerAssert.inheritFailure(a.failed)
+	return integerAssert
+}
+
+// hasValue returns if the actual pointer is not nil, failing the assertion otherwise.
+func (a *PointerAssert[T]) hasValue() bool {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual == nil {
+		a.FailWithMessage("expected pointer to point to a value, but got nil")
+		return false
+	}
+	return true
+}
+
+// addressOf returns the address a pointer points to, to describe pointers by identity in failure messages.
+func addressOf[T any](p *T) string {
+	return fmt.Sprintf("%p", p)
+}
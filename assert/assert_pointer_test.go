@@ -0,0 +1,114 @@
+package assert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/skhome/assertg/assert"
+)
+
+func TestPointerIsNil(t *testing.T) {
+	name := "Frodo"
+
+	fixture := new(fixtureT)
+	assert.ThatPointer(fixture, (*string)(nil)).IsNil()
+	assert.ThatPointer(fixture, &name).IsNotNil()
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, &name).IsNil()
+	assertErrorMessage(t, fixture, "expected pointer to be nil, but it points to <Frodo>")
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, (*string)(nil)).IsNotNil()
+	assertErrorMessage(t, fixture, "expected pointer not to be nil, but got nil")
+}
+
+func TestPointerPointsTo(t *testing.T) {
+	frodo := &hobbit{"Frodo", 33}
+
+	fixture := new(fixtureT)
+	assert.ThatPointer(fixture, frodo).PointsTo(hobbit{"Frodo", 33})
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, frodo).PointsTo(hobbit{"Sam", 38})
+	assertErrorMessage(t, fixture, "expected pointer to point to <{Sam 38}>, but it points to <{Frodo 33}>")
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, (*hobbit)(nil)).PointsTo(hobbit{"Sam", 38})
+	assertErrorMessage(t, fixture, "expected pointer to point to <{Sam 38}>, but got nil")
+}
+
+func TestPointerIsSameAs(t *testing.T) {
+	frodo := &hobbit{"Frodo", 33}
+	other := &hobbit{"Frodo", 33}
+
+	fixture := new(fixtureT)
+	assert.ThatPointer(fixture, frodo).IsSameAs(frodo)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, frodo).IsSameAs(other)
+	assertErrorMessage(t, fixture, fmt.Sprintf("expected pointer to be the same as <%p>, but got <%p>", other, frodo))
+}
+
+func TestPointerValue(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatPointer(fixture, &hobbit{"Frodo", 33}).
+		Value().
+		IsEqualTo(hobbit{"Frodo", 33})
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, &hobbit{"Frodo", 33}).
+		DescribedAs("ring bearer").
+		Value().
+		IsZero()
+	assertErrorMessage(t, fixture, "[ring bearer] expected value to be zero, but got <{Frodo 33}>")
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, (*hobbit)(nil)).
+		Value().
+		Satisfies(func(h hobbit) bool { return h.Age > 30 })
+	assertErrorMessage(t, fixture, "expected pointer to point to a value, but got nil")
+}
+
+func TestPointerValueAsString(t *testing.T) {
+	type nickname string
+	name := nickname("Frodo")
+	age := 33
+
+	fixture := new(fixtureT)
+	assert.ThatPointer(fixture, &name).ValueAsString().StartsWith("Fro")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, &name).ValueAsString().StartsWith("Sam")
+	assertErrorMessage(t, fixture, "expected string to start with <Sam>, but got <Frodo>")
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, &age).ValueAsString().IsNotEmpty()
+	assertErrorMessage(t, fixture, "expected pointer to point to a string, but it points to <33> of type <int>")
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, (*string)(nil)).ValueAsString().IsEmpty()
+	assertErrorMessage(t, fixture, "expected pointer to point to a value, but got nil")
+}
+
+func TestPointerValueAsInteger(t *testing.T) {
+	age := uint8(33)
+	name := "Frodo"
+
+	fixture := new(fixtureT)
+	assert.ThatPointer(fixture, &age).ValueAsInteger().IsBetween(18, 120)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, &age).ValueAsInteger().IsGreaterThan(50)
+	assertErrorMessage(t, fixture, "expected value to be greater than <50>, but got <33>")
+
+	fixture = new(fixtureT)
+	assert.ThatPointer(fixture, &name).ValueAsInteger().IsPositive()
+	assertErrorMessage(t, fixture, "expected pointer to point to an integer, but it points to <Frodo> of type <string>")
+}
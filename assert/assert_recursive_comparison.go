@@ -0,0 +1,164 @@
+package assert
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/skhome/assertg/check"
+)
+
+// RecursiveComparisonAssert provides a field by field comparison of structs, pointers, slices, arrays and maps.
+type RecursiveComparisonAssert[T any] struct {
+	*BaseAssert[RecursiveComparisonAssert[T]]
+	actual     T
+	comparison check.RecursiveComparison
+}
+
+// newRecursiveComparisonAssert creates and returns a new RecursiveComparisonAssert.
+func newRecursiveComparisonAssert[T any](t TestingT, info *WritableAssertionInfo, actual T) *RecursiveComparisonAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	recursiveAssert := &RecursiveComparisonAssert[T]{actual: actual}
+	baseAssert := NewBaseAssert(t, info, recursiveAssert)
+	recursiveAssert.BaseAssert = baseAssert
+	return recursiveAssert
+}
+
+// IgnoringFields ignores the fields with the given paths in the comparison.
+// Paths are given without indexes or map keys, e.g. Lines.Price ignores the price of all lines.
+//
+//	assert.ThatObject(t, order).
+//	       UsingRecursiveComparison().
+//	       IgnoringFields("ID", "Lines.CreatedAt").
+//	       IsEqualTo(expectedOrder)
+func (a *RecursiveComparisonAssert[T]) IgnoringFields(paths ...string) *RecursiveComparisonAssert[T] {
+	a.comparison.IgnoredFields = append(a.comparison.IgnoredFields, paths...)
+	return a
+}
+
+// IgnoringFieldsMatchingRegexes ignores the fields with paths matching any of the given regular expressions.
+// An invalid regular expression fails the assertion.
+//
+//	assert.ThatObject(t, order).
+//	       UsingRecursiveComparison().
+//	       IgnoringFieldsMatchingRegexes(`.*At$`).
+//	       IsEqualTo(expectedOrder)
+func (a *RecursiveComparisonAssert[T]) IgnoringFieldsMatchingRegexes(patterns ...string) *RecursiveComparisonAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	for _, pattern := range patterns {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			a.FailWithMessage("invalid pattern %s: %s", pattern, err.Error())
+			return a
+		}
+		a.comparison.IgnoredFieldsMatching = append(a.comparison.IgnoredFieldsMatching, regex)
+	}
+	return a
+}
+
+// IgnoringUnexportedFields ignores all unexported struct fields in the comparison.
+//
+//	assert.ThatObject(t, order).
+//	       UsingRecursiveComparison().
+//	       IgnoringUnexportedFields().
+//	       IsEqualTo(expectedOrder)
+func (a *RecursiveComparisonAssert[T]) IgnoringUnexportedFields() *RecursiveComparisonAssert[T] {
+	a.comparison.IgnoreUnexported = true
+	return a
+}
+
+// IgnoringCollectionOrder compares slices and arrays ignoring the order of their elements.
+//
+//	assert.ThatObject(t, order).
+//	       UsingRecursiveComparison().
+//	       IgnoringCollectionOrder().
+//	       IsEqualTo(expectedOrder)
+func (a *RecursiveComparisonAssert[T]) IgnoringCollectionOrder() *RecursiveComparisonAssert[T] {
+	a.comparison.IgnoreCollectionOrder = true
+	return a
+}
+
+// ComparingOnlyFields compares only the fields with the given paths (and their nested fields).
+//
+//	assert.ThatObject(t, order).
+//	       UsingRecursiveComparison().
+//	       ComparingOnlyFields("Customer.Name", "Lines.Price").
+//	       IsEqualTo(expectedOrder)
+func (a *RecursiveComparisonAssert[T]) ComparingOnlyFields(paths ...string) *RecursiveComparisonAssert[T] {
+	a.comparison.ComparedFields = append(a.comparison.ComparedFields, paths...)
+	return a
+}
+
+// IsEqualTo verifies that the actual value is equal to the given one field by field.
+// The failure lists the path of every differing field.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, Hobbit{Name: "Frodo", Age: 33}).
+//	       UsingRecursiveComparison().
+//	       IsEqualTo(Hobbit{Name: "Frodo", Age: 33})
+//
+//	// assertion will fail with
+//	// expected values to be equal field by field, but found 1 difference:
+//	// Age: expected <50> but got <33>
+//	assert.ThatObject(t, Hobbit{Name: "Frodo", Age: 33}).
+//	       UsingRecursiveComparison().
+//	       IsEqualTo(Hobbit{Name: "Frodo", Age: 50})
+func (a *RecursiveComparisonAssert[T]) IsEqualTo(expected T) *RecursiveComparisonAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if differences := a.comparison.Compare(a.actual, expected); len(differences) > 0 {
+		a.FailWithMessage("expected values to be equal field by field, but found " +
+			escapeFormat(formatDifferences(differences, a.info.Representation())))
+	}
+	return a
+}
+
+// IsNotEqualTo verifies that the actual value differs from the given one in at least one field.
+//
+//	// assertion will pass
+//	assert.ThatObject(t, Hobbit{Name: "Frodo", Age: 33}).
+//	       UsingRecursiveComparison().
+//	       IsNotEqualTo(Hobbit{Name: "Frodo", Age: 50})
+//
+//	// assertion will fail
+//	assert.ThatObject(t, Hobbit{Name: "Frodo", Age: 33}).
+//	       UsingRecursiveComparison().
+//	       IsNotEqualTo(Hobbit{Name: "Frodo", Age: 33})
+func (a *RecursiveComparisonAssert[T]) IsNotEqualTo(expected T) *RecursiveComparisonAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if check.ObjectsAreEqualRecursively(a.actual, expected, a.comparison) {
+		a.FailWithMessage("expected values not to be equal field by field, but got %s", a.actual)
+	}
+	return a
+}
+
+// formatDifferences formats the differences of a recursive comparison, one per line.
+func formatDifferences(differences []check.Difference, representation Representation) string {
+	var sb strings.Builder
+	if len(differences) == 1 {
+		sb.WriteString("1 difference:")
+	} else {
+		fmt.Fprintf(&sb, "%d differences:", len(differences))
+	}
+	for _, difference := range differences {
+		sb.WriteString("\n")
+		sb.WriteString(formatDifference(difference, representation))
+	}
+	return sb.String()
+}
+
+// formatDifference formats a single difference of a recursive comparison.
+func formatDifference(difference check.Difference, representation Representation) string {
+	message := fmt.Sprintf(difference.Format, representation(difference.Expected), representation(difference.Actual))
+	if difference.Path == "" {
+		return message
+	}
+	return difference.Path + ": " + message
+}
@@ -0,0 +1,231 @@
+package assert_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+)
+
+type orderLine struct {
+	Product string
+	Price   int
+}
+
+type customer struct {
+	Name  string
+	email string
+}
+
+type order struct {
+	ID        int
+	Customer  *customer
+	Lines     []orderLine
+	Tags      map[string]string
+	CreatedAt time.Time
+}
+
+func newOrder() order {
+	return order{
+		ID:       1,
+		Customer: &customer{Name: "Frodo", email: "frodo@shire.me"},
+		Lines: []orderLine{
+			{Product: "pipe", Price: 10},
+			{Product: "cloak", Price: 20},
+			{Product: "rope", Price: 10},
+		},
+		Tags:      map[string]string{"region": "shire"},
+		CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+type recursiveComparisonTest struct {
+	name     string
+	modify   func(o *order)
+	compare  func(a *assert.RecursiveComparisonAssert[order]) *assert.RecursiveComparisonAssert[order]
+	messages []string
+}
+
+func TestRecursiveComparisonIsEqualTo(t *testing.T) {
+	noConfig := func(a *assert.RecursiveComparisonAssert[order]) *assert.RecursiveComparisonAssert[order] { return a }
+	tests := []recursiveComparisonTest{
+		{name: "equal", modify: func(o *order) {}, compare: noConfig},
+		{
+			name:     "nested field",
+			modify:   func(o *order) { o.Lines[2].Price = 12 },
+			compare:  noConfig,
+			messages: []string{"found 1 difference:\nLines[2].Price: expected <10> but got <12>"},
+		},
+		{
+			name: "multiple fields",
+			modify: func(o *order) {
+				o.ID = 2
+				o.Customer.Name = "Sam"
+			},
+			compare: noConfig,
+			messages: []string{
+				"found 2 differences:",
+				"ID: expected <1> but got <2>",
+				"Customer.Name: expected <Frodo> but got <Sam>",
+			},
+		},
+		{
+			name:     "unexported field",
+			modify:   func(o *order) { o.Customer.email = "sam@shire.me" },
+			compare:  noConfig,
+			messages: []string{"Customer.email: expected <frodo@shire.me> but got <sam@shire.me>"},
+		},
+		{
+			name:     "missing element",
+			modify:   func(o *order) { o.Lines = o.Lines[:2] },
+			compare:  noConfig,
+			messages: []string{"Lines[2]: expected <{rope 10}> but got no element"},
+		},
+		{
+			name:     "map entry",
+			modify:   func(o *order) { o.Tags = map[string]string{"region": "bree", "priority": "high"} },
+			compare:  noConfig,
+			messages: []string{"Tags[priority]: expected no entry but got <high>", "Tags[region]: expected <shire> but got <bree>"},
+		},
+		{
+			name:     "time using equal method",
+			modify:   func(o *order) { o.CreatedAt = o.CreatedAt.In(time.FixedZone("CET", 3600)) },
+			compare:  noConfig,
+			messages: nil,
+		},
+		{
+			name:   "ignoring fields",
+			modify: func(o *order) { o.ID = 2; o.Lines[1].Price = 25 },
+			compare: func(a *assert.RecursiveComparisonAssert[order]) *assert.RecursiveComparisonAssert[order] {
+				return a.IgnoringFields("ID", "Lines.Price")
+			},
+		},
+		{
+			name:   "ignoring fields matching regexes",
+			modify: func(o *order) { o.CreatedAt = time.Now() },
+			compare: func(a *assert.RecursiveComparisonAssert[order]) *assert.RecursiveComparisonAssert[order] {
+				return a.IgnoringFieldsMatchingRegexes(`At$`)
+			},
+		},
+		{
+			name:   "ignoring unexported fields",
+			modify: func(o *order) { o.Customer.email = "sam@shire.me" },
+			compare: func(a *assert.RecursiveComparisonAssert[order]) *assert.RecursiveComparisonAssert[order] {
+				return a.IgnoringUnexportedFields()
+			},
+		},
+		{
+			name:   "ignoring collection order",
+			modify: func(o *order) { o.Lines[0], o.Lines[1] = o.Lines[1], o.Lines[0] },
+			compare: func(a *assert.RecursiveComparisonAssert[order]) *assert.RecursiveComparisonAssert[order] {
+				return a.IgnoringCollectionOrder()
+			},
+		},
+		{
+			name:   "ignoring collection order with different elements",
+			modify: func(o *order) { o.Lines[0].Product = "ring" },
+			compare: func(a *assert.RecursiveComparisonAssert[order]) *assert.RecursiveComparisonAssert[order] {
+				return a.IgnoringCollectionOrder()
+			},
+			messages: []string{
+				"Lines: expected element <{pipe 10}> but it was missing",
+				"Lines: expected no element but got <{ring 10}>",
+			},
+		},
+		{
+			name:   "comparing only fields",
+			modify: func(o *order) { o.ID = 2; o.Lines[0].Product = "ring" },
+			compare: func(a *assert.RecursiveComparisonAssert[order]) *assert.RecursiveComparisonAssert[order] {
+				return a.ComparingOnlyFields("Customer.Name", "Lines.Price")
+			},
+		},
+		{
+			name:   "comparing only fields with difference",
+			modify: func(o *order) { o.Lines[0].Price = 11 },
+			compare: func(a *assert.RecursiveComparisonAssert[order]) *assert.RecursiveComparisonAssert[order] {
+				return a.ComparingOnlyFields("Lines.Price")
+			},
+			messages: []string{"Lines[0].Price: expected <10> but got <11>"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := new(fixtureT)
+			actual := newOrder()
+			test.modify(&actual)
+			test.compare(assert.ThatObject(fixture, actual).UsingRecursiveComparison()).IsEqualTo(newOrder())
+			if len(test.messages) == 0 {
+				assertNoError(t, fixture)
+			}
+			for _, message := range test.messages {
+				assertErrorMessage(t, fixture, message)
+			}
+		})
+	}
+}
+
+func TestRecursiveComparisonIsNotEqualTo(t *testing.T) {
+	fixture := new(fixtureT)
+	actual := newOrder()
+	actual.Lines[0].Price = 11
+	assert.ThatObject(fixture, actual).UsingRecursiveComparison().IsNotEqualTo(newOrder())
+	assertNoError(t, fixture)
+
+	assert.ThatObject(fixture, newOrder()).UsingRecursiveComparison().IsNotEqualTo(newOrder())
+	assertErrorMessage(t, fixture, "expected values not to be equal field by field")
+}
+
+func TestRecursiveComparisonUnexportedTime(t *testing.T) {
+	type inner struct {
+		at time.Time
+	}
+	type outer struct {
+		In inner
+	}
+	now := time.Now()
+
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, outer{In: inner{at: now}}).
+		UsingRecursiveComparison().
+		IsEqualTo(outer{In: inner{at: now.Round(0)}})
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	christmas := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+	assert.ThatObject(fixture, outer{In: inner{at: christmas}}).
+		UsingRecursiveComparison().
+		IsEqualTo(outer{In: inner{at: christmas.Add(time.Second)}})
+	assertErrorMessage(t, fixture, "found 1 difference:\nIn.at: expected <2024-12-25T00:00:01Z> but got <2024-12-25T00:00:00Z>")
+}
+
+func TestRecursiveComparisonInvalidPattern(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, newOrder()).
+		UsingRecursiveComparison().
+		IgnoringFieldsMatchingRegexes(`At$`, `Lines[`).
+		IsNotEqualTo(newOrder())
+	assertErrorMessage(t, fixture, "invalid pattern <Lines[>: <error parsing regexp: missing closing ]: `[`>")
+}
+
+func TestRecursiveComparisonKeepsDescription(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, orderLine{"pipe", 10}).
+		DescribedAs("line").
+		UsingRecursiveComparison().
+		IsEqualTo(orderLine{"pipe", 12})
+	assertErrorMessage(t, fixture, "[line] expected values to be equal field by field, but found 1 difference:\nPrice: expected <12> but got <10>")
+}
+
+func TestRecursiveComparisonWithCycles(t *testing.T) {
+	type node struct {
+		Value int
+		Next  *node
+	}
+	a := &node{Value: 1}
+	a.Next = a
+	b := &node{Value: 1}
+	b.Next = b
+	fixture := new(fixtureT)
+	assert.ThatObject(fixture, a).UsingRecursiveComparison().IsEqualTo(b)
+	assertNoError(t, fixture)
+}
@@ -0,0 +1,152 @@
+package assert
+
+// Result holds the results of a call returning a value and an error.
+// Go only passes multiple return values to a function as its sole arguments,
+// so ResultOf captures them to be passed to ThatResult in one step.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// ResultOf captures the value and error returned by a call.
+//
+//	assert.ThatResult(t, assert.ResultOf(strconv.Atoi("42"))).
+//	       Succeeds().
+//	       IsEqualTo(42)
+func ResultOf[T any](value T, err error) Result[T] {
+	return Result[T]{Value: value, Err: err}
+}
+
+// Lookup holds the results of a lookup returning a value and whether it was found, like the comma-ok idiom.
+type Lookup[T any] struct {
+	Value T
+	Ok    bool
+}
+
+// LookupOf captures the value and the ok flag returned by a lookup.
+//
+//	age, ok := ages["Frodo"]
+//	assert.ThatOk(t, assert.LookupOf(age, ok)).
+//	       IsPresent().
+//	       IsEqualTo(33)
+func LookupOf[T any](value T, ok bool) Lookup[T] {
+	return Lookup[T]{Value: value, Ok: ok}
+}
+
+// ResultAssert provides assertions on the results of a call returning a value and an error.
+type ResultAssert[T any] struct {
+	*BaseAssert[ResultAssert[T]]
+	actual Result[T]
+}
+
+// newResultAssert creates and returns a new ResultAssert.
+func newResultAssert[T any](t TestingT, actual Result[T]) *ResultAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	resultAssert := &ResultAssert[T]{actual: actual}
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), resultAssert)
+	resultAssert.BaseAssert = baseAssert
+	return resultAssert
+}
+
+// Succeeds verifies that the call returned a nil error and returns an assertion on the returned value,
+// keeping the description and representation. The following assertions are skipped if the call failed.
+//
+//	// assertion will pass
+//	assert.ThatResult(t, assert.ResultOf(strconv.Atoi("42"))).
+//	       Succeeds().
+//	       IsEqualTo(42)
+//
+//	// assertion will fail
+//	assert.ThatResult(t, assert.ResultOf(strconv.Atoi("forty-two"))).Succeeds()
+func (a *ResultAssert[T]) Succeeds() *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Err != nil {
+		a.FailWithMessage("expected call to succeed, but it failed with %s", a.actual.Err)
+	}
+	objectAssert := &ObjectAssert[T]{actual: a.actual.Value}
+	objectAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, objectAssert)
+	objectAssert.inheritFailure(a.failed)
+	return objectAssert
+}
+
+// Fails verifies that the call returned an error and returns an assertion on that error,
+// keeping the description and representation.
+//
+//	// assertion will pass
+//	assert.ThatResult(t, assert.ResultOf(strconv.Atoi("forty-two"))).
+//	       Fails().
+//	       Is(strconv.ErrSyntax)
+//
+//	// assertion will fail
+//	assert.ThatResult(t, assert.ResultOf(strconv.Atoi("42"))).Fails()
+func (a *ResultAssert[T]) Fails() *ErrorAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Err == nil {
+		a.FailWithMessage("expected call to fail, but it succeeded with %s", a.actual.Value)
+	}
+	errorAssert := &ErrorAssert{actual: a.actual.Err}
+	errorAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, errorAssert)
+	errorAssert.inheritFailure(a.failed)
+	return errorAssert
+}
+
+// OkAssert provides assertions on the results of a lookup returning a value and whether it was found.
+type OkAssert[T any] struct {
+	*BaseAssert[OkAssert[T]]
+	actual Lookup[T]
+}
+
+// newOkAssert creates and returns a new OkAssert.
+func newOkAssert[T any](t TestingT, actual Lookup[T]) *OkAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	okAssert := &OkAssert[T]{actual: actual}
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), okAssert)
+	okAssert.BaseAssert = baseAssert
+	return okAssert
+}
+
+// IsPresent verifies that the lookup found a value and returns an assertion on it,
+// keeping the description and representation. The following assertions are skipped if no value was found.
+//
+//	age, ok := ages["Frodo"]
+//
+//	// assertion will pass
+//	assert.ThatOk(t, assert.LookupOf(age, ok)).
+//	       IsPresent().
+//	       IsEqualTo(33)
+func (a *OkAssert[T]) IsPresent() *ObjectAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.actual.Ok {
+		a.FailWithMessage("expected value to be present, but it was absent")
+	}
+	objectAssert := &ObjectAssert[T]{actual: a.actual.Value}
+	objectAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, objectAssert)
+	objectAssert.inheritFailure(a.failed)
+	return objectAssert
+}
+
+// IsAbsent verifies that the lookup did not find a value.
+//
+//	age, ok := ages["Gandalf"]
+//
+//	// assertion will pass
+//	assert.ThatOk(t, assert.LookupOf(age, ok)).IsAbsent()
+func (a *OkAssert[T]) IsAbsent() *OkAssert[T] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Ok {
+		a.FailWithMessage("expected value to be absent, but got %s", a.actual.Value)
+	}
+	return a
+}
@@ -0,0 +1,75 @@
+package assert_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/skhome/assertg/assert"
+)
+
+func TestResultSucceeds(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatResult(fixture, assert.ResultOf(strconv.Atoi("42"))).
+		Succeeds().
+		IsEqualTo(42)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatResult(fixture, assert.ResultOf(strconv.Atoi("forty-two"))).
+		Succeeds().
+		IsEqualTo(42)
+	assertSingleErrorMessage(t, fixture, `expected call to succeed, but it failed with <strconv.Atoi: parsing "forty-two": invalid syntax>`)
+
+	fixture = new(fixtureT)
+	assert.ThatResult(fixture, assert.ResultOf(strconv.Atoi("42"))).
+		DescribedAs("answer").
+		Succeeds().
+		IsEqualTo(43)
+	assertErrorMessage(t, fixture, "[answer] expected value to equal <43>, but got <42>")
+}
+
+func TestResultFails(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatResult(fixture, assert.ResultOf(strconv.Atoi("forty-two"))).
+		Fails().
+		Is(strconv.ErrSyntax)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatResult(fixture, assert.ResultOf(strconv.Atoi("42"))).
+		Fails().
+		Is(strconv.ErrSyntax)
+	assertSingleErrorMessage(t, fixture, "expected call to fail, but it succeeded with <42>")
+}
+
+func TestOkIsPresent(t *testing.T) {
+	ages := map[string]int{"Frodo": 33}
+
+	fixture := new(fixtureT)
+	age, ok := ages["Frodo"]
+	assert.ThatOk(fixture, assert.LookupOf(age, ok)).
+		IsPresent().
+		IsEqualTo(33)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	age, ok = ages["Gandalf"]
+	assert.ThatOk(fixture, assert.LookupOf(age, ok)).
+		IsPresent().
+		IsEqualTo(33)
+	assertSingleErrorMessage(t, fixture, "expected value to be present, but it was absent")
+}
+
+func TestOkIsAbsent(t *testing.T) {
+	ages := map[string]int{"Frodo": 33}
+
+	fixture := new(fixtureT)
+	age, ok := ages["Gandalf"]
+	assert.ThatOk(fixture, assert.LookupOf(age, ok)).IsAbsent()
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	age, ok = ages["Frodo"]
+	assert.ThatOk(fixture, assert.LookupOf(age, ok)).IsAbsent()
+	assertErrorMessage(t, fixture, "expected value to be absent, but got <33>")
+}
@@ -1,6 +1,11 @@
 package assert
 
-import "github.com/skhome/assertg/check"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skhome/assertg/check"
+)
 
 // SliceAssert provides assertions on slices.
 type SliceAssert[E any] struct {
@@ -189,6 +194,7 @@ func (a *SliceAssert[E]) Contains(elements ...E) *SliceAssert[E] {
 }
 
 // ContainsOnly verifies that the actual slice contains only the given elements and nothing else, in any order and ignoring duplicates.
+// The failure lists the missing and unexpected elements.
 //
 //	// assertions will pass
 //	assert.ThatSlice(t, []string{"a", "b", "c"}).ContainsOnly("a", "b", "c")
@@ -220,7 +226,9 @@ func (a *SliceAssert[E]) ContainsOnly(elements ...E) *SliceAssert[E] {
 		}
 	}
 	if missed || extraneous {
-		a.FailWithMessage("expected slice to contain only %s, but got %s", elements, a.actual)
+		missing, unexpected := check.SliceDistinctDifference(a.actual, elements)
+		format, args := withElementDifferences("expected slice to contain only %s, but got %s", []any{elements, a.actual}, missing, unexpected)
+		a.FailWithMessage(format, args...)
 	}
 	return a
 }
@@ -252,6 +260,7 @@ func (a *SliceAssert[E]) ContainsOnlyOnce(elements ...E) *SliceAssert[E] {
 }
 
 // ContainsExactly verifies that the actual slice contains exactly the given elements and nothing else, in order.
+// The failure lists the missing and unexpected elements and the first index at which the elements differ.
 //
 //	// assertions will pass
 //	assert.ThatSlice(t, []string{"vilya", "nenya", "varya"}).ContainsExactly("vilya", "nenya", "varya")
@@ -264,12 +273,24 @@ func (a *SliceAssert[E]) ContainsExactly(elements ...E) *SliceAssert[E] {
 	}
 	containsExactly := check.SliceIsEqual(elements, a.actual)
 	if !containsExactly {
-		a.FailWithMessage("expected slice to contain exactly %s, but got %s", elements, a.actual)
+		missing, unexpected := check.SliceDifference(a.actual, elements)
+		format, args := withElementDifferences("expected slice to contain exactly %s, but got %s", []any{elements, a.actual}, missing, unexpected)
+		if index := check.SliceFirstMismatch(a.actual, elements); index < min(len(a.actual), len(elements)) {
+			format += fmt.Sprintf("\nelements differ at index %d: expected %%s but got %%s", index)
+			args = append(args, elements[index], a.actual[index])
+			for _, difference := range (check.RecursiveComparison{}).Compare(a.actual[index], elements[index]) {
+				if difference.Path != "" {
+					format += "\n  " + escapeFormat(formatDifference(difference, a.info.Representation()))
+				}
+			}
+		}
+		a.FailWithMessage(format, args...)
 	}
 	return a
 }
 
 // ContainsExactlyInAnyOrder verifies that the actual slice contains exactly the given elements and nothing else, in any order.
+// The failure lists the missing and unexpected elements.
 //
 //	// assertions will pass
 //	assert.ThatSlice(t, []string{"vilya", "nenya", "varya", "vilya"}).
@@ -296,7 +317,9 @@ func (a *SliceAssert[E]) ContainsExactlyInAnyOrder(elements ...E) *SliceAssert[E
 		ok = false
 	}
 	if !ok {
-		a.FailWithMessage("expected slice to contain exactly %v in any order, but got %s", elements, a.actual)
+		missing, unexpected := check.SliceDifference(a.actual, elements)
+		format, args := withElementDifferences("expected slice to contain exactly %v in any order, but got %s", []any{elements, a.actual}, missing, unexpected)
+		a.FailWithMessage(format, args...)
 	}
 	return a
 }
@@ -566,7 +589,7 @@ func (a *SliceAssert[E]) ExtractingStrings(extractor func(elem E) string) *Slice
 	for _, elem := range a.actual {
 		extracted = append(extracted, extractor(elem))
 	}
-	return ThatSlice(a.t, extracted)
+	return ThatSlice(a.testingT(), extracted)
 }
 
 // ExtractingInts extracts a new slice of ints from the actual slice using the given extractor function.
@@ -599,7 +622,7 @@ func (a *SliceAssert[E]) ExtractingInts(extractor func(elem E) int) *SliceAssert
 	for _, elem := range a.actual {
 		extracted = append(extracted, extractor(elem))
 	}
-	return ThatSlice(a.t, extracted)
+	return ThatSlice(a.testingT(), extracted)
 }
 
 // Extracting extracts a new slice from the actual slice using the given extractor function.
@@ -629,5 +652,143 @@ func (a *SliceAssert[E]) Extracting(extractor func(elem E) any) *SliceAssert[any
 	for _, elem := range a.actual {
 		extracted = append(extracted, extractor(elem))
 	}
-	return ThatSlice(a.t, extracted)
+	return ThatSlice(a.testingT(), extracted)
+}
+
+// Extracting extracts a new slice from the slice under test of the given assertion using the given extractor function,
+// keeping the element type of the extracted values. The extracted slice becomes the new object under test,
+// keeping the description and representation.
+//
+//	characterAge := func(character TolkienCharacter) int { return character.age }
+//
+//	assert.Extracting(assert.ThatSlice(t, fellowship), characterAge).
+//	       Contains(33, 2020)
+func Extracting[E, R any](a *SliceAssert[E], extractor func(elem E) R) *SliceAssert[R] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	extracted := make([]R, 0, len(a.actual))
+	for _, elem := range a.actual {
+		extracted = append(extracted, extractor(elem))
+	}
+	return deriveSliceAssert(a, extracted)
+}
+
+// FlatExtracting extracts a slice from each element of the slice under test of the given assertion
+// using the given extractor function and flattens them into one slice. The flattened slice becomes
+// the new object under test, keeping the description and representation.
+//
+//	characterWeapons := func(character TolkienCharacter) []string { return character.weapons }
+//
+//	assert.FlatExtracting(assert.ThatSlice(t, fellowship), characterWeapons).
+//	       Contains("Sting", "Glamdring")
+func FlatExtracting[E, R any](a *SliceAssert[E], extractor func(elem E) []R) *SliceAssert[R] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	var extracted []R
+	for _, elem := range a.actual {
+		extracted = append(extracted, extractor(elem)...)
+	}
+	return deriveSliceAssert(a, extracted)
+}
+
+// ExtractingTuples extracts a tuple of values from each element of the slice under test of the given assertion,
+// one value per extractor function. The slice of tuples becomes the new object under test,
+// keeping the description and representation. More than MaxTupleSize extractors fail the assertion.
+//
+//	characterName := func(character TolkienCharacter) any { return character.name }
+//	characterAge := func(character TolkienCharacter) any { return character.age }
+//
+//	assert.ExtractingTuples(assert.ThatSlice(t, fellowship), characterName, characterAge).
+//	       Contains(assert.TupleOf("Frodo", 33), assert.TupleOf("Gandalf", 2020))
+func ExtractingTuples[E any](a *SliceAssert[E], extractors ...func(elem E) any) *SliceAssert[Tuple] {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if len(extractors) > MaxTupleSize {
+		a.FailWithMessage("expected at most %s extractors, but got %s", MaxTupleSize, len(extractors))
+		return deriveSliceAssert[E, Tuple](a, nil)
+	}
+	extracted := make([]Tuple, 0, len(a.actual))
+	for _, elem := range a.actual {
+		tuple := Tuple{size: len(extractors)}
+		for i, extractor := range extractors {
+			tuple.values[i] = extractor(elem)
+		}
+		extracted = append(extracted, tuple)
+	}
+	return deriveSliceAssert(a, extracted)
+}
+
+// MaxTupleSize is the maximum number of values a Tuple holds.
+const MaxTupleSize = 8
+
+// Tuple holds values extracted from an element by ExtractingTuples.
+// Tuples are comparable, so they can be compared with == and used as map keys as long as their values are
+// comparable. Tuples are equal if they hold equal values in the same order.
+//
+// Tuples format each value with the verb and flags in use, so the hexadecimal and binary representations
+// apply to the values of a tuple, e.g. (46726F646F, 21) instead of the hexadecimal bytes of (Frodo, 33).
+// A custom representation is given the tuples themselves.
+type Tuple struct {
+	values [MaxTupleSize]any
+	size   int
+}
+
+// TupleOf creates and returns a tuple of the given values. It panics if given more than MaxTupleSize values.
+func TupleOf(values ...any) Tuple {
+	if len(values) > MaxTupleSize {
+		panic(fmt.Sprintf("assert: a tuple holds at most %d values, but got %d", MaxTupleSize, len(values)))
+	}
+	tuple := Tuple{size: len(values)}
+	copy(tuple.values[:], values)
+	return tuple
+}
+
+// Len returns the number of values of the tuple.
+func (t Tuple) Len() int {
+	return t.size
+}
+
+// Values returns the values of the tuple.
+func (t Tuple) Values() []any {
+	return append([]any(nil), t.values[:t.size]...)
+}
+
+// String returns the values of the tuple in parentheses, e.g. (Frodo, 33).
+func (t Tuple) String() string {
+	return fmt.Sprintf("%v", t)
+}
+
+// Format formats each value of the tuple with the given verb and flags and writes them in parentheses.
+func (t Tuple) Format(f fmt.State, verb rune) {
+	format := fmt.FormatString(f, verb)
+	values := make([]string, t.size)
+	for i, value := range t.values[:t.size] {
+		values[i] = fmt.Sprintf(format, value)
+	}
+	fmt.Fprint(f, "("+strings.Join(values, ", ")+")")
+}
+
+// deriveSliceAssert returns an assertion on a slice derived from the slice under test of the given assertion,
+// keeping its description, representation and failure state.
+func deriveSliceAssert[E, R any](a *SliceAssert[E], actual []R) *SliceAssert[R] {
+	sliceAssert := &SliceAssert[R]{actual: actual}
+	sliceAssert.BaseAssert = NewBaseAssert(a.testingT(), a.info, sliceAssert)
+	sliceAssert.inheritFailure(a.failed)
+	return sliceAssert
+}
+
+// withElementDifferences appends the missing and unexpected elements to the format and arguments of a failure message.
+func withElementDifferences[E any](format string, args []any, missing, unexpected []E) (string, []any) {
+	if len(missing) > 0 {
+		format += "\nmissing elements: %s"
+		args = append(args, missing)
+	}
+	if len(unexpected) > 0 {
+		format += "\nunexpected elements: %s"
+		args = append(args, unexpected)
+	}
+	return format, args
 }
@@ -208,6 +208,66 @@ func TestSliceContainsExactlyInAnyOrder(t *testing.T) {
 	})
 }
 
+func TestSliceContainsDifferences(t *testing.T) {
+	type line struct {
+		Product string
+		Price   int
+	}
+	tests := []struct {
+		name     string
+		assert   func(fixture *fixtureT)
+		messages []string
+	}{
+		{
+			name: "contains only",
+			assert: func(fixture *fixtureT) {
+				assert.ThatSlice(fixture, []string{"a", "b", "b", "c"}).ContainsOnly("a", "d", "d")
+			},
+			messages: []string{"\nmissing elements: <[d]>\nunexpected elements: <[b c]>"},
+		},
+		{
+			name: "contains exactly in any order",
+			assert: func(fixture *fixtureT) {
+				assert.ThatSlice(fixture, []string{"vilya", "nenya", "vilya"}).ContainsExactlyInAnyOrder("vilya", "narya", "nenya")
+			},
+			messages: []string{"\nmissing elements: <[narya]>\nunexpected elements: <[vilya]>"},
+		},
+		{
+			name: "contains exactly in different order",
+			assert: func(fixture *fixtureT) {
+				assert.ThatSlice(fixture, []string{"vilya", "nenya", "narya"}).ContainsExactly("vilya", "narya", "nenya")
+			},
+			messages: []string{"but got <[vilya nenya narya]>\nelements differ at index 1: expected <narya> but got <nenya>"},
+		},
+		{
+			name: "contains exactly with missing elements",
+			assert: func(fixture *fixtureT) {
+				assert.ThatSlice(fixture, []string{"vilya"}).ContainsExactly("vilya", "nenya")
+			},
+			messages: []string{"but got <[vilya]>\nmissing elements: <[nenya]>"},
+		},
+		{
+			name: "contains exactly with differing fields",
+			assert: func(fixture *fixtureT) {
+				assert.ThatSlice(fixture, []line{{"pipe", 10}, {"rope", 12}}).ContainsExactly(line{"pipe", 10}, line{"rope", 10})
+			},
+			messages: []string{
+				"missing elements: <[{rope 10}]>\nunexpected elements: <[{rope 12}]>",
+				"elements differ at index 1: expected <{rope 10}> but got <{rope 12}>\n  Price: expected <10> but got <12>",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := new(fixtureT)
+			test.assert(fixture)
+			for _, message := range test.messages {
+				assertErrorMessage(t, fixture, message)
+			}
+		})
+	}
+}
+
 func TestSliceContainsSequence(t *testing.T) {
 	elvenRings := []string{"vilya", "nenya", "narya"}
 	tests := []sliceTest{
@@ -407,3 +467,87 @@ func TestSliceExtracting(t *testing.T) {
 		Extracting(characterSpecies).
 		Contains(Species("Hobbit"))
 }
+
+type tolkienCharacter struct {
+	name    string
+	age     int
+	weapons []string
+}
+
+var fellowship = []tolkienCharacter{
+	{name: "Frodo", age: 33, weapons: []string{"Sting"}},
+	{name: "Sam", age: 38},
+	{name: "Gandalf", age: 2020, weapons: []string{"Glamdring", "Staff"}},
+}
+
+func TestExtracting(t *testing.T) {
+	type Age int
+	characterAge := func(character tolkienCharacter) Age { return Age(character.age) }
+
+	fixture := new(fixtureT)
+	assert.Extracting(assert.ThatSlice(fixture, fellowship), characterAge).
+		ContainsExactly(33, 38, 2020)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.Extracting(assert.ThatSlice(fixture, fellowship).DescribedAs("ages"), characterAge).
+		Contains(111)
+	assertErrorMessage(t, fixture, "[ages] expected slice to contain")
+}
+
+func TestFlatExtracting(t *testing.T) {
+	characterWeapons := func(character tolkienCharacter) []string { return character.weapons }
+
+	fixture := new(fixtureT)
+	assert.FlatExtracting(assert.ThatSlice(fixture, fellowship), characterWeapons).
+		ContainsExactly("Sting", "Glamdring", "Staff")
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.FlatExtracting(assert.ThatSlice(fixture, []tolkienCharacter{{name: "Sam"}}), characterWeapons).
+		IsNotEmpty()
+	assertErrorMessage(t, fixture, "expected slice to not be empty")
+}
+
+func TestExtractingTuples(t *testing.T) {
+	characterName := func(character tolkienCharacter) any { return character.name }
+	characterAge := func(character tolkienCharacter) any { return character.age }
+
+	fixture := new(fixtureT)
+	assert.ExtractingTuples(assert.ThatSlice(fixture, fellowship), characterName, characterAge).
+		Contains(assert.TupleOf("Frodo", 33), assert.TupleOf("Gandalf", 2020)).
+		DoesNotContain(assert.TupleOf("Frodo", 38))
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ExtractingTuples(assert.ThatSlice(fixture, fellowship[:1]), characterName, characterAge).
+		ContainsExactly(assert.TupleOf("Sam", 38))
+	assertErrorMessage(t, fixture, "expected slice to contain exactly <[(Sam, 38)]>, but got <[(Frodo, 33)]>")
+
+	fixture = new(fixtureT)
+	assert.ExtractingTuples(assert.ThatSlice(fixture, fellowship[:1]), characterName, characterAge).
+		InHexadecimal().
+		ContainsExactly(assert.TupleOf("Sam", 38))
+	assertErrorMessage(t, fixture, "expected slice to contain exactly <[(53616D, 26)]>, but got <[(46726F646F, 21)]>")
+
+	fixture = new(fixtureT)
+	extractors := make([]func(tolkienCharacter) any, assert.MaxTupleSize+1)
+	assert.ExtractingTuples(assert.ThatSlice(fixture, fellowship), extractors...).IsEmpty()
+	assertErrorMessage(t, fixture, "expected at most <8> extractors, but got <9>")
+}
+
+func TestTupleIsComparable(t *testing.T) {
+	if assert.TupleOf("Frodo", 33) != assert.TupleOf("Frodo", 33) {
+		t.Errorf("expected tuples with equal values to be equal")
+	}
+	if assert.TupleOf("Frodo", 33) == assert.TupleOf("Frodo", 33, nil) {
+		t.Errorf("expected tuples of different sizes not to be equal")
+	}
+	ages := map[assert.Tuple]int{assert.TupleOf("Frodo", "Baggins"): 33}
+	if ages[assert.TupleOf("Frodo", "Baggins")] != 33 {
+		t.Errorf("expected tuple to be usable as a map key")
+	}
+	if values := assert.TupleOf("Frodo", 33).Values(); len(values) != 2 || values[0] != "Frodo" || values[1] != 33 {
+		t.Errorf("expected tuple values <[Frodo 33]>, but got %v", values)
+	}
+}
@@ -221,6 +221,7 @@ func (a *StringAssert) HasLineCount(expectedLineCount int) *StringAssert {
 }
 
 // IsEqualTo verifies that the actual string equals the given one.
+// If any of the strings spans multiple lines, the failure shows a unified diff of both.
 //
 //	// assertion will pass
 //	assert.ThatString(t, "Frodo").IsEqualTo("Frodo")
@@ -232,7 +233,11 @@ func (a *StringAssert) IsEqualTo(expected string) *StringAssert {
 		h.Helper()
 	}
 	if expected != a.actual {
-		a.FailWithMessage("expected string to equal %s, but got %s", expected, a.actual)
+		if isMultiline(expected, a.actual) {
+			a.FailWithMessage("expected strings to be equal, but found differences:\n%s", stringDiff{expected, a.actual})
+		} else {
+			a.FailWithMessage("expected string to equal %s, but got %s", expected, a.actual)
+		}
 	}
 	return a
 }
@@ -3,6 +3,7 @@ package assert_test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/skhome/assertg/assert"
@@ -177,6 +178,76 @@ func TestStringIsEqualTo(t *testing.T) {
 	})
 }
 
+func TestStringIsEqualToMultiline(t *testing.T) {
+	tests := []struct {
+		name     string
+		actual   string
+		expected string
+		message  string
+	}{
+		{
+			name:     "changed line",
+			actual:   "one\ntwo\nthree\nfour\nfive\nsix\nseven",
+			expected: "one\ntwo\nthree\nfour\nfive\nsix\neight",
+			message: "expected strings to be equal, but found differences:\n" +
+				"--- expected\n+++ actual\n@@ -4,4 +4,4 @@\n four\n five\n six\n-[-eight-]\n+{+seven+}",
+		},
+		{
+			name:     "character level",
+			actual:   "name: Frodo\nage: 33",
+			expected: "name: Frodo\nage: 50",
+			message:  "@@ -1,2 +1,2 @@\n name: Frodo\n-age: [-50-]\n+age: {+33+}",
+		},
+		{
+			name:     "inserted and deleted lines",
+			actual:   "a\nb\nx\nc",
+			expected: "a\nb\nc\nd",
+			message:  "@@ -1,4 +1,4 @@\n a\n b\n+x\n c\n-d",
+		},
+		{
+			name:     "trailing whitespace",
+			actual:   "line  \nend",
+			expected: "line\nend",
+			message:  "-line\n+line{+··+}\n end",
+		},
+		{
+			name:     "line endings",
+			actual:   "line\r\nend",
+			expected: "line\nend",
+			message:  "-line\n+line{+␍+}\n end",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fixture := new(fixtureT)
+			assert.ThatString(fixture, test.actual).IsEqualTo(test.expected)
+			assertErrorMessage(t, fixture, test.message)
+		})
+	}
+}
+
+func TestStringIsEqualToLargeMultiline(t *testing.T) {
+	var expected, actual []string
+	for i := 0; i < 2000; i++ {
+		expected = append(expected, fmt.Sprintf("expected %d", i))
+		actual = append(actual, fmt.Sprintf("actual %d", i))
+	}
+	fixture := new(fixtureT)
+	assert.ThatString(fixture, "same\n"+strings.Join(actual, "\n")).IsEqualTo("same\n" + strings.Join(expected, "\n"))
+	assertErrorMessage(t, fixture, "@@ -1,2001 +1,2001 @@\n same\n-[-expected-] 0\n-[-expected-] 1\n")
+	assertErrorMessage(t, fixture, "\n-[-expected-] 1999\n+{+actual+} 0\n")
+}
+
+func TestStringIsEqualToMultilineWithDescription(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatString(fixture, "a\nb").DescribedAs("template").IsEqualTo("a\nc")
+	assertErrorMessage(t, fixture, "[template] expected strings to be equal, but found differences:\n--- expected")
+
+	fixture = new(fixtureT)
+	assert.ThatString(fixture, "a\nb").WithFailMessage("rendered template differs").IsEqualTo("a\nc")
+	assertErrorMessage(t, fixture, "rendered template differs")
+}
+
 func TestStringIsNotEqualTo(t *testing.T) {
 	tests := []stringTest{
 		{value: "Frodo", other: "frodo", ok: true},
@@ -7,11 +7,13 @@ import (
 )
 
 type fixtureT struct {
-	message string
+	message  string
+	failures int
 }
 
 func (f *fixtureT) Errorf(format string, args ...any) {
 	f.message = fmt.Sprintf(format, args...)
+	f.failures++
 }
 
 func (f *fixtureT) Helper() {}
@@ -42,6 +44,14 @@ func assertErrorMessage(t *testing.T, fixture *fixtureT, message string) {
 	}
 }
 
+func assertSingleErrorMessage(t *testing.T, fixture *fixtureT, message string) {
+	t.Helper()
+	if fixture.failures != 1 || fixture.message != message {
+		t.Errorf("expected to fail once with error message %q, but got %d failures with last message %#v",
+			message, fixture.failures, fixture.message)
+	}
+}
+
 func assertNoError(t *testing.T, fixture *fixtureT) {
 	t.Helper()
 	if len(fixture.message) > 0 {
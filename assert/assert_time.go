@@ -0,0 +1,389 @@
+package assert
+
+import (
+	"time"
+
+	"github.com/skhome/assertg/check"
+)
+
+// TimeAssert provides assertions on time values.
+type TimeAssert struct {
+	*BaseAssert[TimeAssert]
+	actual time.Time
+}
+
+// newTimeAssert creates and returns a new TimeAssert.
+func newTimeAssert(t TestingT, actual time.Time) *TimeAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	timeAssert := &TimeAssert{actual: actual}
+	baseAssert := NewBaseAssert(t, NewWritableAssertionInfo(), timeAssert)
+	timeAssert.BaseAssert = baseAssert
+	return timeAssert
+}
+
+// IsEqualTo verifies that the actual time is the same instant as the given one.
+// Locations and monotonic clock readings are ignored.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)).
+//	       IsEqualTo(time.Date(2024, 1, 1, 13, 0, 0, 0, time.FixedZone("CET", 3600)))
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)).
+//	       IsEqualTo(time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC))
+func (a *TimeAssert) IsEqualTo(expected time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.actual.Equal(expected) {
+		a.FailWithMessage("expected time to equal %s, but got %s", expected, a.actual)
+	}
+	return a
+}
+
+// IsNotEqualTo verifies that the actual time is not the same instant as the given one.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)).
+//	       IsNotEqualTo(time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC))
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)).
+//	       IsNotEqualTo(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+func (a *TimeAssert) IsNotEqualTo(expected time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Equal(expected) {
+		a.FailWithMessage("expected time not to equal %s, but got %s", expected, a.actual)
+	}
+	return a
+}
+
+// IsEqualIgnoringMonotonic verifies that the actual time is the same instant in the same location as the given one,
+// ignoring monotonic clock readings.
+//
+//	now := time.Now()
+//
+//	// assertion will pass
+//	assert.ThatTime(t, now).IsEqualIgnoringMonotonic(now.Round(0))
+//
+//	// assertion will fail
+//	assert.ThatTime(t, now).IsEqualIgnoringMonotonic(now.UTC())
+func (a *TimeAssert) IsEqualIgnoringMonotonic(expected time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.TimesAreEqualIgnoringMonotonic(a.actual, expected) {
+		a.FailWithMessage("expected time to equal %s in location %s, but got %s in location %s",
+			expected, expected.Location(), a.actual, a.actual.Location())
+	}
+	return a
+}
+
+// IsZero verifies that the actual time is the zero time.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Time{}).IsZero()
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Now()).IsZero()
+func (a *TimeAssert) IsZero() *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.actual.IsZero() {
+		a.FailWithMessage("expected time to be zero, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsNotZero verifies that the actual time is not the zero time.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Now()).IsNotZero()
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Time{}).IsNotZero()
+func (a *TimeAssert) IsNotZero() *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.IsZero() {
+		a.FailWithMessage("expected time not to be zero, but got %s", a.actual)
+	}
+	return a
+}
+
+// IsBefore verifies that the actual time is before the given one.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, yesterday).IsBefore(today)
+//
+//	// assertions will fail
+//	assert.ThatTime(t, today).IsBefore(today)
+//	assert.ThatTime(t, today).IsBefore(yesterday)
+func (a *TimeAssert) IsBefore(other time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.actual.Before(other) {
+		a.FailWithMessage("expected time to be before %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsBeforeOrEqualTo verifies that the actual time is before or the same instant as the given one.
+//
+//	// assertions will pass
+//	assert.ThatTime(t, yesterday).IsBeforeOrEqualTo(today)
+//	assert.ThatTime(t, today).IsBeforeOrEqualTo(today)
+//
+//	// assertion will fail
+//	assert.ThatTime(t, today).IsBeforeOrEqualTo(yesterday)
+func (a *TimeAssert) IsBeforeOrEqualTo(other time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.After(other) {
+		a.FailWithMessage("expected time to be before or equal to %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsAfter verifies that the actual time is after the given one.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, today).IsAfter(yesterday)
+//
+//	// assertions will fail
+//	assert.ThatTime(t, today).IsAfter(today)
+//	assert.ThatTime(t, yesterday).IsAfter(today)
+func (a *TimeAssert) IsAfter(other time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !a.actual.After(other) {
+		a.FailWithMessage("expected time to be after %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsAfterOrEqualTo verifies that the actual time is after or the same instant as the given one.
+//
+//	// assertions will pass
+//	assert.ThatTime(t, today).IsAfterOrEqualTo(yesterday)
+//	assert.ThatTime(t, today).IsAfterOrEqualTo(today)
+//
+//	// assertion will fail
+//	assert.ThatTime(t, yesterday).IsAfterOrEqualTo(today)
+func (a *TimeAssert) IsAfterOrEqualTo(other time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Before(other) {
+		a.FailWithMessage("expected time to be after or equal to %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsBetween verifies that the actual time is between the start and end time (inclusive).
+//
+//	// assertions will pass
+//	assert.ThatTime(t, today).IsBetween(yesterday, tomorrow)
+//	assert.ThatTime(t, today).IsBetween(today, tomorrow)
+//
+//	// assertion will fail
+//	assert.ThatTime(t, yesterday).IsBetween(today, tomorrow)
+func (a *TimeAssert) IsBetween(startInclusive, endInclusive time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.TimeIsBetween(a.actual, startInclusive, endInclusive) {
+		a.FailWithMessage("expected time to be between %s and %s, but got %s", startInclusive, endInclusive, a.actual)
+	}
+	return a
+}
+
+// IsCloseTo verifies that the actual time differs from the given one by at most the given tolerance.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Now()).IsCloseTo(time.Now(), time.Second)
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Now()).IsCloseTo(time.Now().Add(time.Minute), time.Second)
+func (a *TimeAssert) IsCloseTo(other time.Time, tolerance time.Duration) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.TimeIsCloseTo(a.actual, other, tolerance) {
+		a.FailWithMessage("expected time to be close to %s within %s, but got %s with a difference of %s",
+			other, tolerance, a.actual, a.actual.Sub(other))
+	}
+	return a
+}
+
+// IsInSameMonthAs verifies that the actual time is in the same month as the given one.
+// The given time is converted to the location of the actual time before comparing.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+//	       IsInSameMonthAs(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+//	       IsInSameMonthAs(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+func (a *TimeAssert) IsInSameMonthAs(other time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.TimesAreInSameMonth(a.actual, other) {
+		a.FailWithMessage("expected time to be in the same month as %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsInSameDayAs verifies that the actual time is on the same day as the given one.
+// The given time is converted to the location of the actual time before comparing.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+//	       IsInSameDayAs(time.Date(2024, 1, 1, 23, 59, 0, 0, time.UTC))
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+//	       IsInSameDayAs(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+func (a *TimeAssert) IsInSameDayAs(other time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.TimesAreInSameDay(a.actual, other) {
+		a.FailWithMessage("expected time to be in the same day as %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsInSameHourAs verifies that the actual time is in the same hour as the given one.
+// The given time is converted to the location of the actual time before comparing.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)).
+//	       IsInSameHourAs(time.Date(2024, 1, 1, 12, 59, 0, 0, time.UTC))
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)).
+//	       IsInSameHourAs(time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC))
+func (a *TimeAssert) IsInSameHourAs(other time.Time) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if !check.TimesAreInSameHour(a.actual, other) {
+		a.FailWithMessage("expected time to be in the same hour as %s, but got %s", other, a.actual)
+	}
+	return a
+}
+
+// IsInUTC verifies that the location of the actual time is UTC.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Now().UTC()).IsInUTC()
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Now().In(berlin)).IsInUTC()
+func (a *TimeAssert) IsInUTC() *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Location() != time.UTC {
+		a.FailWithMessage("expected time to be in UTC, but got %s in location %s", a.actual, a.actual.Location())
+	}
+	return a
+}
+
+// IsInLocation verifies that the actual time has the given location.
+// Locations are compared by name.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Now().In(berlin)).IsInLocation(berlin)
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Now().UTC()).IsInLocation(berlin)
+func (a *TimeAssert) IsInLocation(location *time.Location) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Location().String() != location.String() {
+		a.FailWithMessage("expected time to be in location %s, but got %s in location %s", location, a.actual, a.actual.Location())
+	}
+	return a
+}
+
+// HasYear verifies that the actual time is in the given year.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).HasYear(2024)
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).HasYear(2023)
+func (a *TimeAssert) HasYear(year int) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Year() != year {
+		a.FailWithMessage("expected time to have year %s, but got %s", year, a.actual)
+	}
+	return a
+}
+
+// HasMonth verifies that the actual time is in the given month.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).HasMonth(time.January)
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).HasMonth(time.February)
+func (a *TimeAssert) HasMonth(month time.Month) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Month() != month {
+		a.FailWithMessage("expected time to have month %s, but got %s", month, a.actual)
+	}
+	return a
+}
+
+// HasDay verifies that the actual time is on the given day of the month.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).HasDay(1)
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).HasDay(2)
+func (a *TimeAssert) HasDay(day int) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Day() != day {
+		a.FailWithMessage("expected time to have day %s, but got %s", day, a.actual)
+	}
+	return a
+}
+
+// HasWeekday verifies that the actual time is on the given day of the week.
+//
+//	// assertion will pass
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).HasWeekday(time.Monday)
+//
+//	// assertion will fail
+//	assert.ThatTime(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).HasWeekday(time.Sunday)
+func (a *TimeAssert) HasWeekday(weekday time.Weekday) *TimeAssert {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+	if a.actual.Weekday() != weekday {
+		a.FailWithMessage("expected time to have weekday %s, but got %s for %s", weekday, a.actual.Weekday(), a.actual)
+	}
+	return a
+}
@@ -0,0 +1,270 @@
+package assert_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+)
+
+type timeTest struct {
+	actual    time.Time
+	other     time.Time
+	end       time.Time
+	tolerance time.Duration
+	ok        bool
+}
+
+var (
+	cet       = time.FixedZone("CET", 3600)
+	yesterday = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	today     = time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	tomorrow  = time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s not available: %v", name, err)
+	}
+	return location
+}
+
+func rfc3339(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+func TestTimeIsEqualTo(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, other: today, ok: true},
+		{actual: today, other: today.In(cet), ok: true},
+		{actual: today, other: tomorrow, ok: false},
+	}
+	messageFormat := "expected time to equal <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsNotEqualTo(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, other: tomorrow, ok: true},
+		{actual: today, other: today.In(cet), ok: false},
+	}
+	messageFormat := "expected time not to equal <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsNotEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsEqualIgnoringMonotonic(t *testing.T) {
+	now := time.Now()
+	tests := []timeTest{
+		{actual: now, other: now.Round(0), ok: true},
+		{actual: today, other: today.In(cet), ok: false},
+		{actual: today, other: today.In(time.FixedZone("UTC", 0)), ok: true},
+		{actual: today.In(loadLocation(t, "Europe/Berlin")), other: today.In(loadLocation(t, "Europe/Berlin")), ok: true},
+	}
+	messageFormat := "expected time to equal <%s> in location <%s>, but got <%s> in location <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsEqualIgnoringMonotonic(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), test.other.Location(), rfc3339(test.actual), test.actual.Location())
+	})
+}
+
+func TestTimeIsZero(t *testing.T) {
+	tests := []timeTest{
+		{actual: time.Time{}, ok: true},
+		{actual: today, ok: false},
+	}
+	messageFormat := "expected time to be zero, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsZero()
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsNotZero(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, ok: true},
+		{actual: time.Time{}, ok: false},
+	}
+	messageFormat := "expected time not to be zero, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsNotZero()
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsBefore(t *testing.T) {
+	tests := []timeTest{
+		{actual: yesterday, other: today, ok: true},
+		{actual: today, other: today, ok: false},
+		{actual: today, other: yesterday, ok: false},
+	}
+	messageFormat := "expected time to be before <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsBefore(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsBeforeOrEqualTo(t *testing.T) {
+	tests := []timeTest{
+		{actual: yesterday, other: today, ok: true},
+		{actual: today, other: today, ok: true},
+		{actual: today, other: yesterday, ok: false},
+	}
+	messageFormat := "expected time to be before or equal to <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsBeforeOrEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsAfter(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, other: yesterday, ok: true},
+		{actual: today, other: today, ok: false},
+		{actual: yesterday, other: today, ok: false},
+	}
+	messageFormat := "expected time to be after <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsAfter(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsAfterOrEqualTo(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, other: yesterday, ok: true},
+		{actual: today, other: today, ok: true},
+		{actual: yesterday, other: today, ok: false},
+	}
+	messageFormat := "expected time to be after or equal to <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsAfterOrEqualTo(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsBetween(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, other: yesterday, end: tomorrow, ok: true},
+		{actual: today, other: today, end: tomorrow, ok: true},
+		{actual: today, other: yesterday, end: today, ok: true},
+		{actual: yesterday, other: today, end: tomorrow, ok: false},
+	}
+	messageFormat := "expected time to be between <%s> and <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsBetween(test.other, test.end)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.end), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsCloseTo(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, other: today.Add(time.Second), tolerance: time.Second, ok: true},
+		{actual: today, other: today.Add(-time.Second), tolerance: time.Second, ok: true},
+		{actual: today, other: today.Add(time.Minute), tolerance: time.Second, ok: false},
+	}
+	messageFormat := "expected time to be close to <%s> within <%s>, but got <%s> with a difference of <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsCloseTo(test.other, test.tolerance)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), test.tolerance, rfc3339(test.actual), test.actual.Sub(test.other))
+	})
+}
+
+func TestTimeIsInSameMonthAs(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, other: tomorrow, ok: true},
+		{actual: today, other: today.AddDate(0, 1, 0), ok: false},
+		{actual: time.Date(2024, 2, 1, 0, 30, 0, 0, cet), other: time.Date(2024, 1, 31, 23, 30, 0, 0, time.UTC), ok: true},
+	}
+	messageFormat := "expected time to be in the same month as <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsInSameMonthAs(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsInSameDayAs(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, other: today.Add(time.Hour), ok: true},
+		{actual: time.Date(2024, 1, 2, 0, 30, 0, 0, cet), other: time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC), ok: true},
+		{actual: today, other: tomorrow, ok: false},
+		{actual: time.Date(2024, 1, 2, 0, 30, 0, 0, time.UTC), other: time.Date(2024, 1, 2, 0, 30, 0, 0, cet), ok: false},
+	}
+	messageFormat := "expected time to be in the same day as <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsInSameDayAs(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsInSameHourAs(t *testing.T) {
+	fallBack := time.Date(2024, 10, 27, 0, 0, 0, 0, time.UTC).In(loadLocation(t, "Europe/Berlin"))
+	tests := []timeTest{
+		{actual: today, other: today.Add(59 * time.Minute), ok: true},
+		{actual: today, other: today.Add(time.Hour), ok: false},
+		{actual: today, other: tomorrow, ok: false},
+		// 02:00 CEST and 02:00 CET on the daylight saving fall-back day
+		{actual: fallBack, other: fallBack.Add(30 * time.Minute), ok: true},
+		{actual: fallBack, other: fallBack.Add(time.Hour), ok: false},
+		{actual: fallBack.Add(time.Hour), other: fallBack, ok: false},
+	}
+	messageFormat := "expected time to be in the same hour as <%s>, but got <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsInSameHourAs(test.other)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.other), rfc3339(test.actual))
+	})
+}
+
+func TestTimeIsInUTC(t *testing.T) {
+	tests := []timeTest{
+		{actual: today, ok: true},
+		{actual: today.In(cet), ok: false},
+	}
+	messageFormat := "expected time to be in UTC, but got <%s> in location <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsInUTC()
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.actual), test.actual.Location())
+	})
+}
+
+func TestTimeIsInLocation(t *testing.T) {
+	tests := []timeTest{
+		{actual: today.In(cet), ok: true},
+		{actual: today, ok: false},
+	}
+	messageFormat := "expected time to be in location <CET>, but got <%s> in location <%s>"
+	runTests(t, tests)(func(fixture *fixtureT, test timeTest) (bool, string) {
+		assert.ThatTime(fixture, test.actual).IsInLocation(cet)
+		return test.ok, fmt.Sprintf(messageFormat, rfc3339(test.actual), test.actual.Location())
+	})
+}
+
+func TestTimeHasYearMonthDay(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.ThatTime(fixture, today).HasYear(2024).HasMonth(time.January).HasDay(2).HasWeekday(time.Tuesday)
+	assertNoError(t, fixture)
+
+	fixture = new(fixtureT)
+	assert.ThatTime(fixture, today).HasYear(2023)
+	assertErrorMessage(t, fixture, "expected time to have year <2023>, but got <2024-01-02T12:00:00Z>")
+
+	fixture = new(fixtureT)
+	assert.ThatTime(fixture, today).HasMonth(time.March)
+	assertErrorMessage(t, fixture, "expected time to have month <March>, but got <2024-01-02T12:00:00Z>")
+
+	fixture = new(fixtureT)
+	assert.ThatTime(fixture, today).HasDay(3)
+	assertErrorMessage(t, fixture, "expected time to have day <3>, but got <2024-01-02T12:00:00Z>")
+
+	fixture = new(fixtureT)
+	assert.ThatTime(fixture, today).HasWeekday(time.Sunday)
+	assertErrorMessage(t, fixture, "expected time to have weekday <Sunday>, but got <Tuesday> for <2024-01-02T12:00:00Z>")
+}
@@ -94,6 +94,11 @@ func (i *WritableAssertionInfo) UsingBinaryRepresentation() {
 	i.representation = BinaryRepresentation
 }
 
+// UsingFloatBitsRepresentation uses the IEEE 754 bit pattern for actual and expected float values.
+func (i *WritableAssertionInfo) UsingFloatBitsRepresentation() {
+	i.representation = FloatBitsRepresentation
+}
+
 // UsingRepresentation uses the given representation for actual and expected values.
 func (i *WritableAssertionInfo) UsingRepresentation(representation Representation) {
 	i.representation = representation
@@ -0,0 +1,221 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around changed lines of a diff.
+const diffContext = 3
+
+// maxDiffCells limits the size of the table used to compute a line diff.
+// Larger differences are shown as all expected lines removed and all actual lines added.
+const maxDiffCells = 1 << 20
+
+// stringDiff holds two strings whose differences are rendered as unified diff in an assertion error message.
+type stringDiff struct {
+	expected string
+	actual   string
+}
+
+// isMultiline returns if any of the given strings spans multiple lines.
+func isMultiline(values ...string) bool {
+	for _, value := range values {
+		if strings.Contains(value, "\n") {
+			return true
+		}
+	}
+	return false
+}
+
+// diffOp is the operation of a line in a diff.
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+// diffLine is a line of a diff along with its index in the expected and actual text.
+type diffLine struct {
+	op       diffOp
+	text     string
+	expected int
+	actual   int
+}
+
+// unified renders the differences as unified diff from expected to actual.
+// Changed parts of lines are highlighted as [-removed-] and {+added+},
+// trailing whitespace and carriage returns are made visible.
+func (d stringDiff) unified() string {
+	lines := diffLines(strings.Split(d.expected, "\n"), strings.Split(d.actual, "\n"))
+	var sb strings.Builder
+	sb.WriteString("--- expected\n+++ actual")
+	for _, hunk := range diffHunks(lines) {
+		writeHunk(&sb, lines[hunk[0]:hunk[1]])
+	}
+	return sb.String()
+}
+
+// diffLines computes a line diff based on the longest common subsequence of both texts.
+// If the lines between the common leading and trailing lines are too many for the table,
+// they are replaced as a whole instead.
+func diffLines(expected, actual []string) []diffLine {
+	prefix := 0
+	for prefix < len(expected) && prefix < len(actual) && expected[prefix] == actual[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(expected)-prefix && suffix < len(actual)-prefix &&
+		expected[len(expected)-1-suffix] == actual[len(actual)-1-suffix] {
+		suffix++
+	}
+	exp := expected[prefix : len(expected)-suffix]
+	act := actual[prefix : len(actual)-suffix]
+
+	lines := make([]diffLine, 0, len(expected)+len(act))
+	for k := 0; k < prefix; k++ {
+		lines = append(lines, diffLine{diffEqual, expected[k], k, k})
+	}
+	if (len(exp)+1)*(len(act)+1) <= maxDiffCells {
+		lines = appendCommonSubsequenceDiff(lines, exp, act, prefix)
+	} else {
+		for i, line := range exp {
+			lines = append(lines, diffLine{diffDelete, line, prefix + i, prefix})
+		}
+		for j, line := range act {
+			lines = append(lines, diffLine{diffInsert, line, prefix + len(exp), prefix + j})
+		}
+	}
+	for k := suffix; k > 0; k-- {
+		lines = append(lines, diffLine{diffEqual, expected[len(expected)-k], len(expected) - k, len(actual) - k})
+	}
+	return lines
+}
+
+// appendCommonSubsequenceDiff appends the diff of two texts based on their longest common subsequence,
+// with line indices offset by the given number of common leading lines.
+func appendCommonSubsequenceDiff(lines []diffLine, exp, act []string, offset int) []diffLine {
+	// lcs[i][j] is the length of the longest common subsequence of exp[i:] and act[j:]
+	lcs := make([][]int, len(exp)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(act)+1)
+	}
+	for i := len(exp) - 1; i >= 0; i-- {
+		for j := len(act) - 1; j >= 0; j-- {
+			if exp[i] == act[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(exp) || j < len(act) {
+		switch {
+		case i < len(exp) && j < len(act) && exp[i] == act[j]:
+			lines = append(lines, diffLine{diffEqual, exp[i], offset + i, offset + j})
+			i++
+			j++
+		case i < len(exp) && (j == len(act) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{diffDelete, exp[i], offset + i, offset + j})
+			i++
+		default:
+			lines = append(lines, diffLine{diffInsert, act[j], offset + i, offset + j})
+			j++
+		}
+	}
+	return lines
+}
+
+// diffHunks returns the ranges of diff lines to show, each surrounding changes with unchanged context lines.
+func diffHunks(lines []diffLine) [][2]int {
+	var hunks [][2]int
+	for i, line := range lines {
+		if line.op == diffEqual {
+			continue
+		}
+		start, end := max(i-diffContext, 0), min(i+diffContext+1, len(lines))
+		if len(hunks) > 0 && start <= hunks[len(hunks)-1][1] {
+			hunks[len(hunks)-1][1] = end
+		} else {
+			hunks = append(hunks, [2]int{start, end})
+		}
+	}
+	return hunks
+}
+
+// writeHunk writes a hunk header and its lines, highlighting the changes of replaced lines.
+func writeHunk(sb *strings.Builder, lines []diffLine) {
+	expectedCount, actualCount := 0, 0
+	for _, line := range lines {
+		if line.op != diffInsert {
+			expectedCount++
+		}
+		if line.op != diffDelete {
+			actualCount++
+		}
+	}
+	fmt.Fprintf(sb, "\n@@ -%s +%s @@", hunkRange(lines[0].expected, expectedCount), hunkRange(lines[0].actual, actualCount))
+	for i := 0; i < len(lines); {
+		if lines[i].op == diffEqual {
+			sb.WriteString("\n " + visibleWhitespace(lines[i].text))
+			i++
+			continue
+		}
+		var deleted, inserted []string
+		for ; i < len(lines) && lines[i].op == diffDelete; i++ {
+			deleted = append(deleted, visibleWhitespace(lines[i].text))
+		}
+		for ; i < len(lines) && lines[i].op == diffInsert; i++ {
+			inserted = append(inserted, visibleWhitespace(lines[i].text))
+		}
+		for k := 0; k < min(len(deleted), len(inserted)); k++ {
+			deleted[k], inserted[k] = highlightChanges(deleted[k], inserted[k])
+		}
+		for _, line := range deleted {
+			sb.WriteString("\n-" + line)
+		}
+		for _, line := range inserted {
+			sb.WriteString("\n+" + line)
+		}
+	}
+}
+
+// hunkRange formats the start line and line count of a hunk.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// highlightChanges marks the part that differs between two versions of a line.
+func highlightChanges(expected, actual string) (string, string) {
+	exp, act := []rune(expected), []rune(actual)
+	prefix := 0
+	for prefix < len(exp) && prefix < len(act) && exp[prefix] == act[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(exp)-prefix && suffix < len(act)-prefix && exp[len(exp)-1-suffix] == act[len(act)-1-suffix] {
+		suffix++
+	}
+	return highlight(exp, prefix, len(exp)-suffix, "[-", "-]"), highlight(act, prefix, len(act)-suffix, "{+", "+}")
+}
+
+// highlight surrounds the given range of a line with markers, unless the range is empty.
+func highlight(line []rune, start, end int, opening, closing string) string {
+	if start == end {
+		return string(line)
+	}
+	return string(line[:start]) + opening + string(line[start:end]) + closing + string(line[end:])
+}
+
+// visibleWhitespace makes trailing spaces, tabs and carriage returns of a line visible.
+func visibleWhitespace(line string) string {
+	trimmed := strings.TrimRight(line, " \t\r")
+	return trimmed + strings.NewReplacer(" ", "·", "\t", "→", "\r", "␍").Replace(line[len(trimmed):])
+}
@@ -9,3 +9,18 @@ type tHelper interface {
 type TestingT interface {
 	Errorf(format string, args ...any)
 }
+
+// tCleanup is an interface to register functions that run when the test finishes.
+type tCleanup interface {
+	Cleanup(func())
+}
+
+// tFailNow is an interface to stop the test after a failed assertion.
+type tFailNow interface {
+	FailNow()
+}
+
+// tSkip is an interface to skip the test when an assumption does not hold.
+type tSkip interface {
+	Skipf(format string, args ...any)
+}
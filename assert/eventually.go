@@ -0,0 +1,247 @@
+package assert
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Backoff returns the delay before the next attempt of a polling assertion, given the number of attempts so far.
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff waits the same interval between all attempts.
+func ConstantBackoff(interval time.Duration) Backoff {
+	return func(int) time.Duration {
+		return interval
+	}
+}
+
+// LinearBackoff waits the initial delay after the first attempt and increases the delay by the given increment
+// after every further attempt, up to the given maximum.
+func LinearBackoff(initial, increment, maximum time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		return min(initial+time.Duration(attempt-1)*increment, maximum)
+	}
+}
+
+// ExponentialBackoff waits the initial delay after the first attempt and doubles the delay after every further attempt,
+// up to the given maximum.
+func ExponentialBackoff(initial, maximum time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		delay := initial
+		for i := 1; i < attempt && delay < maximum; i++ {
+			delay *= 2
+		}
+		return min(delay, maximum)
+	}
+}
+
+// Eventually verifies that the assertions of the given block pass within the given timeout.
+// The block is run against a recording TestingT every interval until all of its assertions pass.
+// If the timeout elapses, the failures of the last attempt are reported.
+// A panic in the block fails the attempt, an attempt still running after the timeout is abandoned and fails.
+//
+//	assert.Eventually(t, time.Second, 10*time.Millisecond, func(c assert.TestingT) {
+//	       assert.ThatInteger(c, counter.Load()).IsEqualTo(3)
+//	})
+func Eventually(t TestingT, timeout, interval time.Duration, block func(c TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	attempts, failures := pollUntilSuccess(ctx, ConstantBackoff(interval), block)
+	if failures != nil {
+		t, mode := unwrapT(t)
+		report(t, mode, fmt.Sprintf("expected block to pass within %s, but it still failed after %d attempts:\n%s",
+			DefaultRepresentation(timeout), attempts, formatAttemptFailures(failures)))
+		return false
+	}
+	return true
+}
+
+// EventuallyContext verifies that the assertions of the given block pass before the given context is done.
+// The block is run against a recording TestingT, waiting between attempts as given by the backoff,
+// until all of its assertions pass. If the context is done, the failures of the last attempt are reported.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	assert.EventuallyContext(ctx, t, assert.ExponentialBackoff(10*time.Millisecond, time.Second), func(c assert.TestingT) {
+//	       assert.ThatString(c, service.Status()).IsEqualTo("ready")
+//	})
+func EventuallyContext(ctx context.Context, t TestingT, backoff Backoff, block func(c TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	attempts, failures := pollUntilSuccess(ctx, backoff, block)
+	if failures != nil {
+		t, mode := unwrapT(t)
+		report(t, mode, fmt.Sprintf("expected block to pass before the context was done (%s), but it still failed after %d attempts:\n%s",
+			context.Cause(ctx), attempts, formatAttemptFailures(failures)))
+		return false
+	}
+	return true
+}
+
+// Consistently verifies that the assertions of the given block pass for the whole given duration.
+// The block is run against a recording TestingT every interval, the failures of the first failed attempt are reported.
+// A panic in the block fails the attempt, an attempt still running after the duration is abandoned and fails.
+//
+//	assert.Consistently(t, time.Second, 10*time.Millisecond, func(c assert.TestingT) {
+//	       assert.ThatInteger(c, connections.Load()).IsLessThanOrEqualTo(10)
+//	})
+func Consistently(t TestingT, duration, interval time.Duration, block func(c TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	defer cancel()
+	attempts, failures := pollUntilFailure(ctx, ConstantBackoff(interval), block)
+	if failures != nil {
+		t, mode := unwrapT(t)
+		report(t, mode, fmt.Sprintf("expected block to pass for %s, but it failed on attempt %d:\n%s",
+			DefaultRepresentation(duration), attempts, formatAttemptFailures(failures)))
+		return false
+	}
+	return true
+}
+
+// ConsistentlyContext verifies that the assertions of the given block pass until the given context is done.
+// The block is run against a recording TestingT, waiting between attempts as given by the backoff.
+// The failures of the first failed attempt are reported.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	assert.ConsistentlyContext(ctx, t, assert.ConstantBackoff(10*time.Millisecond), func(c assert.TestingT) {
+//	       assert.ThatBool(c, cache.IsStale()).IsFalse()
+//	})
+func ConsistentlyContext(ctx context.Context, t TestingT, backoff Backoff, block func(c TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	attempts, failures := pollUntilFailure(ctx, backoff, block)
+	if failures != nil {
+		t, mode := unwrapT(t)
+		report(t, mode, fmt.Sprintf("expected block to pass until the context was done, but it failed on attempt %d:\n%s",
+			attempts, formatAttemptFailures(failures)))
+		return false
+	}
+	return true
+}
+
+// pollUntilSuccess runs the block until it passes or the context is done.
+// It returns the number of attempts and the failures of the last attempt, which are nil if the block passed.
+func pollUntilSuccess(ctx context.Context, backoff Backoff, block func(c TestingT)) (int, []string) {
+	for attempt := 1; ; attempt++ {
+		failures, finished := runAttempt(ctx, block)
+		if !finished {
+			return attempt, append(failures, "block did not finish before the context was done")
+		}
+		if failures == nil || !wait(ctx, backoff(attempt)) {
+			return attempt, failures
+		}
+	}
+}
+
+// pollUntilFailure runs the block until it fails or the context is done.
+// It returns the number of attempts and the failures of the failed attempt, which are nil if the block always passed.
+// An attempt that is still running when the context is done fails as well.
+func pollUntilFailure(ctx context.Context, backoff Backoff, block func(c TestingT)) (int, []string) {
+	for attempt := 1; ; attempt++ {
+		failures, finished := runAttempt(ctx, block)
+		if !finished {
+			return attempt, append(failures, "block did not finish before the context was done")
+		}
+		if failures != nil || !wait(ctx, backoff(attempt)) {
+			return attempt, failures
+		}
+	}
+}
+
+// wait waits for the given delay and returns false if the context is done before.
+func wait(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// abandonAttemptAfter is how long an attempt that is still running when the context is done may take to finish
+// before it is abandoned.
+const abandonAttemptAfter = 100 * time.Millisecond
+
+// runAttempt runs the block once in its own goroutine, so assertions that stop the test only stop the attempt.
+// A panic in the block is recorded as a failure. If the block does not finish shortly after the context is done,
+// the attempt is abandoned and reported as not finished.
+// It returns the recorded failures, or nil if there were none, and whether the block finished.
+func runAttempt(ctx context.Context, block func(c TestingT)) ([]string, bool) {
+	recorder := new(recordingT)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				recorder.Errorf("block panicked: %v", r)
+			}
+		}()
+		block(recorder)
+	}()
+	select {
+	case <-done:
+		return recorder.recorded(), true
+	case <-ctx.Done():
+	}
+	timer := time.NewTimer(abandonAttemptAfter)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return recorder.recorded(), true
+	case <-timer.C:
+		return recorder.recorded(), false
+	}
+}
+
+// formatAttemptFailures combines the failures of an attempt into one message.
+func formatAttemptFailures(failures []string) string {
+	if len(failures) == 1 {
+		return failures[0]
+	}
+	return formatFailures(failures)
+}
+
+// recordingT is a TestingT that records the failures of a single attempt of a polling assertion.
+type recordingT struct {
+	mu       sync.Mutex
+	failures []string
+}
+
+// Errorf records an assertion failure.
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+// recorded returns a copy of the failures recorded so far, or nil if there were none.
+func (r *recordingT) recorded() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures == nil {
+		return nil
+	}
+	return append([]string(nil), r.failures...)
+}
+
+// Helper marks the calling function as a test helper.
+func (r *recordingT) Helper() {}
+
+// FailNow stops the current attempt.
+func (r *recordingT) FailNow() {
+	runtime.Goexit()
+}
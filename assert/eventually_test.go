@@ -0,0 +1,200 @@
+package assert_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+)
+
+func TestEventually(t *testing.T) {
+	fixture := new(fixtureT)
+	attempts := 0
+	ok := assert.Eventually(fixture, time.Second, time.Millisecond, func(c assert.TestingT) {
+		attempts++
+		assert.ThatInteger(c, attempts).IsEqualTo(3)
+	})
+
+	assertNoError(t, fixture)
+	if !ok || attempts != 3 {
+		t.Errorf("expected block to pass on attempt 3, but got %v after %d attempts", ok, attempts)
+	}
+}
+
+func TestEventuallyTimesOut(t *testing.T) {
+	fixture := new(fixtureT)
+	attempts := 0
+	ok := assert.Eventually(fixture, 20*time.Millisecond, time.Millisecond, func(c assert.TestingT) {
+		attempts++
+		assert.ThatInteger(c, attempts).IsNegative()
+		assert.ThatString(c, "Frodo").IsEqualTo("Sam")
+	})
+
+	if ok {
+		t.Errorf("expected block not to pass")
+	}
+	assertErrorMessage(t, fixture, "expected block to pass within <20ms>, but it still failed after")
+	assertErrorMessage(t, fixture, "attempts:\nmultiple failures (2 failures)")
+	assertErrorMessage(t, fixture, "-- failure 2 --\nexpected string to equal <Sam>, but got <Frodo>")
+}
+
+func TestEventuallyKeepsPercentSigns(t *testing.T) {
+	fixture := new(fixtureT)
+	assert.Eventually(fixture, 10*time.Millisecond, time.Millisecond, func(c assert.TestingT) {
+		assert.ThatString(c, "100%d").IsEqualTo("50%")
+	})
+
+	assertErrorMessage(t, fixture, "attempts:\nexpected string to equal <50%>, but got <100%d>")
+}
+
+func TestEventuallyStopsAttemptOnRequire(t *testing.T) {
+	fixture := new(fixtureT)
+	reached := false
+	assert.Eventually(fixture, 10*time.Millisecond, time.Millisecond, func(c assert.TestingT) {
+		assert.ThatBool(assert.Require(c), false).IsTrue()
+		reached = true
+	})
+
+	if reached {
+		t.Errorf("expected attempt to be stopped after the failed assertion")
+	}
+	assertErrorMessage(t, fixture, "attempts:\nexpected value to be true, but got <false>")
+}
+
+func TestEventuallyRecordsPanics(t *testing.T) {
+	fixture := new(fixtureT)
+	ok := assert.Eventually(fixture, 10*time.Millisecond, time.Millisecond, func(c assert.TestingT) {
+		panic("the ring is lost")
+	})
+
+	if ok {
+		t.Errorf("expected block not to pass")
+	}
+	assertErrorMessage(t, fixture, "attempts:\nblock panicked: the ring is lost")
+}
+
+func TestEventuallyAbandonsBlockedAttempt(t *testing.T) {
+	fixture := new(fixtureT)
+	blocked := make(chan struct{})
+	defer close(blocked)
+	ok := assert.Eventually(fixture, 10*time.Millisecond, time.Millisecond, func(c assert.TestingT) {
+		assert.ThatBool(c, false).IsTrue()
+		<-blocked
+	})
+
+	if ok {
+		t.Errorf("expected block not to pass")
+	}
+	assertErrorMessage(t, fixture, "after 1 attempts:\nmultiple failures (2 failures)\n"+
+		"-- failure 1 --\nexpected value to be true, but got <false>\n"+
+		"-- failure 2 --\nblock did not finish before the context was done")
+}
+
+func TestEventuallyContext(t *testing.T) {
+	fixture := new(fixtureT)
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	ok := assert.EventuallyContext(ctx, fixture, assert.ConstantBackoff(time.Millisecond), func(c assert.TestingT) {
+		attempts++
+		if attempts == 2 {
+			cancel()
+		}
+		assert.ThatInteger(c, attempts).IsZero()
+	})
+
+	if ok || attempts != 2 {
+		t.Errorf("expected block to fail after 2 attempts, but got %v after %d attempts", ok, attempts)
+	}
+	assertErrorMessage(t, fixture, "expected block to pass before the context was done (context canceled), but it still failed after 2 attempts:\nexpected value to be zero, but got <2>")
+}
+
+func TestConsistently(t *testing.T) {
+	fixture := new(fixtureT)
+	attempts := 0
+	ok := assert.Consistently(fixture, 20*time.Millisecond, time.Millisecond, func(c assert.TestingT) {
+		attempts++
+		assert.ThatInteger(c, attempts).IsPositive()
+	})
+
+	assertNoError(t, fixture)
+	if !ok || attempts < 2 {
+		t.Errorf("expected block to pass on several attempts, but got %v after %d attempts", ok, attempts)
+	}
+}
+
+func TestConsistentlyFails(t *testing.T) {
+	fixture := new(fixtureT)
+	attempts := 0
+	ok := assert.Consistently(fixture, time.Second, time.Millisecond, func(c assert.TestingT) {
+		attempts++
+		assert.ThatInteger(c, attempts).IsLessThan(3)
+	})
+
+	if ok || attempts != 3 {
+		t.Errorf("expected block to fail on attempt 3, but got %v after %d attempts", ok, attempts)
+	}
+	assertErrorMessage(t, fixture, "expected block to pass for <1s>, but it failed on attempt 3:\nexpected value to be less than <3>, but got <3>")
+}
+
+func TestConsistentlyRecordsPanics(t *testing.T) {
+	fixture := new(fixtureT)
+	ok := assert.Consistently(fixture, time.Second, time.Millisecond, func(c assert.TestingT) {
+		panic("the ring is lost")
+	})
+
+	if ok {
+		t.Errorf("expected block not to pass")
+	}
+	assertErrorMessage(t, fixture, "failed on attempt 1:\nblock panicked: the ring is lost")
+}
+
+func TestConsistentlyFailsOnBlockedAttempt(t *testing.T) {
+	fixture := new(fixtureT)
+	blocked := make(chan struct{})
+	defer close(blocked)
+	ok := assert.Consistently(fixture, 50*time.Millisecond, time.Millisecond, func(c assert.TestingT) {
+		<-blocked
+	})
+
+	if ok {
+		t.Errorf("expected block not to pass")
+	}
+	assertErrorMessage(t, fixture, "but it failed on attempt 1:\nblock did not finish before the context was done")
+}
+
+func TestConsistentlyContext(t *testing.T) {
+	fixture := new(fixtureT)
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	ok := assert.ConsistentlyContext(ctx, fixture, assert.ConstantBackoff(time.Millisecond), func(c assert.TestingT) {
+		attempts++
+		if attempts == 3 {
+			cancel()
+		}
+	})
+
+	assertNoError(t, fixture)
+	if !ok || attempts != 3 {
+		t.Errorf("expected block to pass until the context was canceled, but got %v after %d attempts", ok, attempts)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		backoff  assert.Backoff
+		expected []time.Duration
+	}{
+		{"constant", assert.ConstantBackoff(time.Second), []time.Duration{time.Second, time.Second, time.Second}},
+		{"linear", assert.LinearBackoff(time.Second, 2*time.Second, 4*time.Second), []time.Duration{time.Second, 3 * time.Second, 4 * time.Second}},
+		{"exponential", assert.ExponentialBackoff(time.Second, 5*time.Second), []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}},
+	}
+	for _, test := range tests {
+		for i, expected := range test.expected {
+			if delay := test.backoff(i + 1); delay != expected {
+				t.Errorf("expected %s backoff to wait %s before attempt %d, but got %s", test.name, expected, i+2, delay)
+			}
+		}
+	}
+}
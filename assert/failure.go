@@ -0,0 +1,95 @@
+package assert
+
+// failureMode defines how a failed assertion is reported to the test.
+type failureMode int
+
+const (
+	// failureModeError reports the failure and continues the test.
+	failureModeError failureMode = iota
+	// failureModeFailNow reports the failure and stops the test.
+	failureModeFailNow
+	// failureModeSkip skips the test instead of failing it.
+	failureModeSkip
+)
+
+// modeT wraps a TestingT to change how failed assertions are reported.
+// Assertions unwrap it when they are created, so helper frames are still reported on the wrapped TestingT.
+type modeT struct {
+	t    TestingT
+	mode failureMode
+}
+
+// Errorf reports an assertion error to the wrapped TestingT.
+func (m modeT) Errorf(format string, args ...any) {
+	m.t.Errorf(format, args...)
+}
+
+// Helper marks the calling function as a test helper.
+func (m modeT) Helper() {
+	if h, ok := m.t.(tHelper); ok {
+		h.Helper()
+	}
+}
+
+// Require returns a TestingT for assertions that stop the test on failure by calling FailNow.
+// If the given TestingT has no FailNow method, failures are reported with Errorf and the test continues,
+// the failure message then notes that the test could not be stopped.
+//
+//	// stops the test if err is not nil
+//	assert.ThatError(assert.Require(t), err).IsNil()
+func Require(t TestingT) TestingT {
+	t, _ = unwrapT(t)
+	return modeT{t: t, mode: failureModeFailNow}
+}
+
+// Assume returns a TestingT for assertions that skip the test by calling Skipf instead of failing it.
+// If the given TestingT has no Skipf method, the test fails with Errorf instead,
+// the failure message then notes that the test could not be skipped.
+//
+//	// skips the test if no database is configured
+//	assert.ThatString(assert.Assume(t), os.Getenv("DB_URL")).IsNotEmpty()
+func Assume(t TestingT) TestingT {
+	t, _ = unwrapT(t)
+	return modeT{t: t, mode: failureModeSkip}
+}
+
+// report reports a formatted failure message to the given TestingT using the given failure mode.
+func report(t TestingT, mode failureMode, message string) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	switch mode {
+	case failureModeSkip:
+		if s, ok := t.(tSkip); ok {
+			s.Skipf("%s", message)
+			return
+		}
+		t.Errorf("%s", message+"\n(the test could not be skipped, it has no Skipf method)")
+	case failureModeFailNow:
+		f, ok := t.(tFailNow)
+		if !ok {
+			t.Errorf("%s", message+"\n(the test could not be stopped, it has no FailNow method)")
+			return
+		}
+		t.Errorf("%s", message)
+		f.FailNow()
+	default:
+		t.Errorf("%s", message)
+	}
+}
+
+// unwrapT returns the TestingT and failure mode of the given TestingT.
+func unwrapT(t TestingT) (TestingT, failureMode) {
+	if m, ok := t.(modeT); ok {
+		return m.t, m.mode
+	}
+	return t, failureModeError
+}
+
+// wrapT returns a TestingT that reports failures to the given TestingT using the given failure mode.
+func wrapT(t TestingT, mode failureMode) TestingT {
+	if mode == failureModeError {
+		return t
+	}
+	return modeT{t: t, mode: mode}
+}
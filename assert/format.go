@@ -1,6 +1,9 @@
 package assert
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // DescriptionFormatter formats a description to be included in assertion errors.
 type DescriptionFormatter func(description Description) string
@@ -46,5 +49,13 @@ func (f CompactMessageFormatter) formatArgs(representation Representation, args
 }
 
 func (f CompactMessageFormatter) asText(representation Representation, value any) string {
+	if diff, ok := value.(stringDiff); ok {
+		return diff.unified()
+	}
 	return representation(value)
 }
+
+// escapeFormat escapes all formatting verbs in the given text, so it can be used as part of a format string.
+func escapeFormat(text string) string {
+	return strings.ReplaceAll(text, "%", "%%")
+}
@@ -1,24 +1,50 @@
 package assert
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/skhome/assertg/check"
+)
 
 // Representation provides a textual representation of a value in a certain format.
 type Representation func(value any) string
 
 // Returns the default representation of a given value.
+// Times are represented in RFC 3339 format with nanoseconds.
 func DefaultRepresentation(value any) string {
 	if value == nil {
 		return fmt.Sprintf("%v", value)
 	}
+	if t, ok := value.(time.Time); ok {
+		return fmt.Sprintf("<%s>", t.Format(time.RFC3339Nano))
+	}
 	return fmt.Sprintf("<%v>", value)
 }
 
 // HexadecimalRepresentation returns the hexadecimal representation of a given integer value.
+// Float values are represented as hexadecimal floats, e.g. 0X1.8P+00 for 1.5.
 func HexadecimalRepresentation(value any) string {
 	return fmt.Sprintf("<%X>", value)
 }
 
 // BinaryRepresentation returns the binary representation of a given integer value.
+// Float values are represented with a decimal mantissa and a binary exponent, e.g. 6755399441055744p-52 for 1.5.
 func BinaryRepresentation(value any) string {
 	return fmt.Sprintf("<%b>", value)
 }
+
+// FloatBitsRepresentation returns the IEEE 754 bit pattern of a given float value in hexadecimal,
+// e.g. 0x3ff8000000000000 for 1.5. It tells apart negative zero and NaN values with different payloads.
+// Values that are not floats use the default representation.
+func FloatBitsRepresentation(value any) string {
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Float32:
+		return fmt.Sprintf("<%#08x>", check.FloatBits(float32(v.Float())))
+	case reflect.Float64:
+		return fmt.Sprintf("<%#016x>", check.FloatBits(v.Float()))
+	default:
+		return DefaultRepresentation(value)
+	}
+}
@@ -0,0 +1,141 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SoftAssertions collects the failures of all assertions started from it and reports them together.
+//
+//	soft := assert.Soft(t)
+//	soft.ThatString("Frodo").StartsWith("Sam")
+//	soft.ThatBool(false).IsTrue()
+//	assert.ThatSlice(soft, []string{"Merry"}).Contains("Pippin")
+//
+//	// reports all three failures at once
+//	soft.AssertAll()
+//
+// Generic entry points like ThatSlice or ThatInteger accept a SoftAssertions as their TestingT.
+// Unlike regular assertions, a chain started from SoftAssertions continues after its first failure.
+type SoftAssertions struct {
+	t        TestingT
+	mu       sync.Mutex
+	failures []string
+}
+
+// Soft creates and returns new SoftAssertions for the given test.
+// If the test supports cleanup functions, the collected failures are reported when the test finishes.
+func Soft(t TestingT) *SoftAssertions {
+	soft := &SoftAssertions{t: t}
+	if c, ok := t.(tCleanup); ok {
+		c.Cleanup(soft.AssertAll)
+	}
+	return soft
+}
+
+// Errorf records an assertion failure.
+func (s *SoftAssertions) Errorf(format string, args ...any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, fmt.Sprintf(format, args...))
+}
+
+// Helper marks the calling function as a test helper.
+func (s *SoftAssertions) Helper() {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+}
+
+// Errors returns the failures collected so far.
+func (s *SoftAssertions) Errors() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.failures...)
+}
+
+// WasSuccess returns if no failures have been collected so far.
+func (s *SoftAssertions) WasSuccess() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.failures) == 0
+}
+
+// AssertAll reports all collected failures as a single assertion error.
+// The collected failures are reset, so calling AssertAll again only reports new failures.
+func (s *SoftAssertions) AssertAll() {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+	s.mu.Lock()
+	failures := s.failures
+	s.failures = nil
+	s.mu.Unlock()
+	if len(failures) > 0 {
+		s.t.Errorf("%s", formatFailures(failures))
+	}
+}
+
+// ThatString starts a soft assertion on a string.
+func (s *SoftAssertions) ThatString(actual string) *StringAssert {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+	return newStringAssert(s, actual)
+}
+
+// ThatBool starts a soft assertion on a bool.
+func (s *SoftAssertions) ThatBool(actual bool) *BoolAssert {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+	return newBoolAssert(s, actual)
+}
+
+// ThatError starts a soft assertion on an error.
+func (s *SoftAssertions) ThatError(actual error) *ErrorAssert {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+	return newErrorAssert(s, actual)
+}
+
+// ThatTime starts a soft assertion on a time.
+func (s *SoftAssertions) ThatTime(actual time.Time) *TimeAssert {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+	return newTimeAssert(s, actual)
+}
+
+// ThatDuration starts a soft assertion on a duration.
+func (s *SoftAssertions) ThatDuration(actual time.Duration) *DurationAssert {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+	return newDurationAssert(s, actual)
+}
+
+// ThatCode runs the given function and starts a soft assertion on whether it panics.
+func (s *SoftAssertions) ThatCode(code func()) *CodeAssert {
+	if h, ok := s.t.(tHelper); ok {
+		h.Helper()
+	}
+	return newCodeAssert(s, code)
+}
+
+// formatFailures combines multiple failures into one message with a numbered list.
+func formatFailures(failures []string) string {
+	var sb strings.Builder
+	if len(failures) == 1 {
+		sb.WriteString("multiple failures (1 failure)")
+	} else {
+		fmt.Fprintf(&sb, "multiple failures (%d failures)", len(failures))
+	}
+	for i, failure := range failures {
+		fmt.Fprintf(&sb, "\n-- failure %d --\n%s", i+1, failure)
+	}
+	return sb.String()
+}
@@ -0,0 +1,158 @@
+package assert_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+)
+
+type cleanupT struct {
+	fixtureT
+	cleanups []func()
+}
+
+func (c *cleanupT) Cleanup(f func()) {
+	c.cleanups = append(c.cleanups, f)
+}
+
+func TestSoftAssertAll(t *testing.T) {
+	fixture := new(fixtureT)
+	soft := assert.Soft(fixture)
+
+	soft.ThatString("Frodo").DescribedAs("name").StartsWith("Sam")
+	soft.ThatBool(true).IsTrue()
+	assert.ThatSlice(soft, []string{"Merry"}).Contains("Pippin")
+	soft.ThatError(nil).IsNotNil()
+
+	assertNoError(t, fixture)
+	soft.AssertAll()
+
+	expected := []string{
+		"multiple failures (3 failures)",
+		"-- failure 1 --\n[name] expected string to start with <Sam>, but got <Frodo>",
+		"-- failure 2 --\nexpected slice to contain <[Pippin]>, but got <[Merry]>",
+		"-- failure 3 --\nexpected error not to be nil, but got <nil>",
+	}
+	for _, message := range expected {
+		assertErrorMessage(t, fixture, message)
+	}
+}
+
+func TestSoftAssertAllWithoutFailures(t *testing.T) {
+	fixture := new(fixtureT)
+	soft := assert.Soft(fixture)
+
+	soft.ThatString("Frodo").StartsWith("Fro")
+	soft.AssertAll()
+
+	assertNoError(t, fixture)
+	if !soft.WasSuccess() {
+		t.Errorf("expected soft assertions to be successful, but got %v", soft.Errors())
+	}
+}
+
+func TestSoftAssertAllResetsFailures(t *testing.T) {
+	fixture := new(fixtureT)
+	soft := assert.Soft(fixture)
+
+	soft.ThatError(errors.New("boom")).IsNil()
+	if len(soft.Errors()) != 1 {
+		t.Errorf("expected one collected failure, but got %v", soft.Errors())
+	}
+	soft.AssertAll()
+	assertErrorMessage(t, fixture, "multiple failures (1 failure)")
+
+	fixture.message = ""
+	soft.AssertAll()
+	assertNoError(t, fixture)
+}
+
+func TestSoftCollectsEveryFailureOfAChain(t *testing.T) {
+	fixture := new(fixtureT)
+	soft := assert.Soft(fixture)
+
+	soft.ThatString("Frodo").StartsWith("Sam").EndsWith("x").HasLength(2)
+
+	if failures := soft.Errors(); len(failures) != 3 {
+		t.Errorf("expected soft assertions to collect 3 failures, but got %v", failures)
+	}
+
+	assert.ThatString(fixture, "Frodo").StartsWith("Sam").EndsWith("x").HasLength(2)
+	assertSingleErrorMessage(t, fixture, "expected string to start with <Sam>, but got <Frodo>")
+}
+
+func TestSoftSkipsAssertionsDerivedFromAFailure(t *testing.T) {
+	fixture := new(fixtureT)
+	soft := assert.Soft(fixture)
+
+	assert.ThatPointer[string](soft, nil).ValueAsString().StartsWith("Sam").HasLength(2)
+
+	if failures := soft.Errors(); len(failures) != 1 {
+		t.Errorf("expected soft assertions to collect 1 failure, but got %v", failures)
+	}
+}
+
+func TestSoftEntryPoints(t *testing.T) {
+	fixture := new(fixtureT)
+	soft := assert.Soft(fixture)
+
+	soft.ThatTime(time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)).IsZero()
+	soft.ThatDuration(time.Second).IsNegative()
+	soft.ThatCode(func() {}).Panics()
+	soft.AssertAll()
+
+	expected := []string{
+		"multiple failures (3 failures)",
+		"-- failure 1 --\nexpected time to be zero",
+		"-- failure 2 --\nexpected duration to be negative",
+		"-- failure 3 --\nexpected code to panic",
+	}
+	for _, message := range expected {
+		assertErrorMessage(t, fixture, message)
+	}
+}
+
+func TestSoftReportsOnCleanup(t *testing.T) {
+	fixture := new(cleanupT)
+	soft := assert.Soft(fixture)
+
+	soft.ThatString("Frodo").IsEmpty()
+	if len(fixture.cleanups) != 1 {
+		t.Fatalf("expected a cleanup function to be registered, but got %d", len(fixture.cleanups))
+	}
+	fixture.cleanups[0]()
+
+	if !strings.Contains(fixture.message, "expected string to be empty, but got <Frodo>") {
+		t.Errorf("expected collected failures to be reported on cleanup, but got %#v", fixture.message)
+	}
+}
+
+type helperT struct {
+	fixtureT
+	helpers int
+}
+
+func (h *helperT) Helper() {
+	h.helpers++
+}
+
+func TestSoftMarksHelpers(t *testing.T) {
+	fixture := new(helperT)
+	soft := assert.Soft(fixture)
+
+	soft.ThatString("Frodo")
+	soft.ThatBool(true)
+	soft.ThatError(nil)
+	if fixture.helpers < 3 {
+		t.Errorf("expected entry points to mark helpers on the wrapped test, but got %d calls", fixture.helpers)
+	}
+
+	fixture.helpers = 0
+	soft.AssertAll()
+	if fixture.helpers == 0 {
+		t.Errorf("expected AssertAll to mark a helper on the wrapped test, but got none")
+	}
+}
@@ -0,0 +1,208 @@
+// Package assume provides the assertions of the assert package as assumptions.
+// An assumption that does not hold skips the test instead of failing it.
+// A TestingT without a Skipf method can not be skipped, the test then fails like in the assert package.
+//
+//	func TestDatabase(t *testing.T) {
+//	  // skips the test if no database is configured
+//	  assume.ThatString(t, os.Getenv("DB_URL")).IsNotEmpty()
+//	  ...
+//	}
+package assume
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+	"golang.org/x/exp/constraints"
+)
+
+// tHelper is a helper interface to signal that this function is a test helper.
+type tHelper interface {
+	Helper()
+}
+
+// ThatString starts assumptions on a string.
+func ThatString(t assert.TestingT, actual string) *assert.StringAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatString(assert.Assume(t), actual)
+}
+
+// ThatSlice starts assumptions on a slice.
+func ThatSlice[T ~[]E, E any](t assert.TestingT, actual T) *assert.SliceAssert[E] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatSlice(assert.Assume(t), actual)
+}
+
+// ThatMap starts assumptions on a map.
+func ThatMap[M ~map[K]V, K comparable, V any](t assert.TestingT, actual M) *assert.MapAssert[K, V] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatMap(assert.Assume(t), actual)
+}
+
+// ThatBool starts assumptions on a bool.
+func ThatBool(t assert.TestingT, actual bool) *assert.BoolAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatBool(assert.Assume(t), actual)
+}
+
+// ThatInteger starts assumptions on an integer.
+func ThatInteger[T constraints.Integer](t assert.TestingT, actual T) *assert.IntegerAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatInteger(assert.Assume(t), actual)
+}
+
+// ThatFloat starts assumptions on a float.
+func ThatFloat[T constraints.Float](t assert.TestingT, actual T) *assert.FloatAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatFloat(assert.Assume(t), actual)
+}
+
+// ThatFloats starts assumptions on a slice of floats.
+func ThatFloats[T ~[]E, E constraints.Float](t assert.TestingT, actual T) *assert.FloatsAssert[E] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatFloats(assert.Assume(t), actual)
+}
+
+// ThatMatrix starts assumptions on a matrix of floats.
+func ThatMatrix[M ~[][]E, E constraints.Float](t assert.TestingT, actual M) *assert.MatrixAssert[E] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatMatrix(assert.Assume(t), actual)
+}
+
+// ThatOrdered starts assumptions on a value of any ordered type, compared with cmp.Compare.
+func ThatOrdered[T cmp.Ordered](t assert.TestingT, actual T) *assert.OrderedAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatOrdered(assert.Assume(t), actual)
+}
+
+// ThatComparable starts assumptions on a value that is compared with the given function.
+func ThatComparable[T any](t assert.TestingT, actual T, compare func(a, b T) int) *assert.OrderedAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatComparable(assert.Assume(t), actual, compare)
+}
+
+// ThatError starts assumptions on an error.
+func ThatError(t assert.TestingT, actual error) *assert.ErrorAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatError(assert.Assume(t), actual)
+}
+
+// ErrorAs finds the first error of type T in the chain of the actual error and starts assumptions on it.
+func ErrorAs[T error](t assert.TestingT, actual error) *assert.ObjectAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ErrorAs[T](assert.Assume(t), actual)
+}
+
+// ThatObject starts assumptions on an arbitrary value.
+func ThatObject[T any](t assert.TestingT, actual T) *assert.ObjectAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatObject(assert.Assume(t), actual)
+}
+
+// ThatPointer starts assumptions on a pointer.
+func ThatPointer[T any](t assert.TestingT, actual *T) *assert.PointerAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatPointer(assert.Assume(t), actual)
+}
+
+// ThatResult starts assumptions on the value and error returned by a call.
+func ThatResult[T any](t assert.TestingT, actual assert.Result[T]) *assert.ResultAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatResult(assert.Assume(t), actual)
+}
+
+// ThatOk starts assumptions on the value and ok flag returned by a lookup.
+func ThatOk[T any](t assert.TestingT, actual assert.Lookup[T]) *assert.OkAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatOk(assert.Assume(t), actual)
+}
+
+// ThatTime starts assumptions on a time.
+func ThatTime(t assert.TestingT, actual time.Time) *assert.TimeAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatTime(assert.Assume(t), actual)
+}
+
+// ThatDuration starts assumptions on a duration.
+func ThatDuration(t assert.TestingT, actual time.Duration) *assert.DurationAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatDuration(assert.Assume(t), actual)
+}
+
+// Eventually verifies that the assertions of the given block pass within the given timeout, skipping the test on failure.
+func Eventually(t assert.TestingT, timeout, interval time.Duration, block func(c assert.TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.Eventually(assert.Assume(t), timeout, interval, block)
+}
+
+// EventuallyContext verifies that the assertions of the given block pass before the given context is done, skipping the test on failure.
+func EventuallyContext(ctx context.Context, t assert.TestingT, backoff assert.Backoff, block func(c assert.TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.EventuallyContext(ctx, assert.Assume(t), backoff, block)
+}
+
+// Consistently verifies that the assertions of the given block pass for the whole given duration, skipping the test on failure.
+func Consistently(t assert.TestingT, duration, interval time.Duration, block func(c assert.TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.Consistently(assert.Assume(t), duration, interval, block)
+}
+
+// ConsistentlyContext verifies that the assertions of the given block pass until the given context is done, skipping the test on failure.
+func ConsistentlyContext(ctx context.Context, t assert.TestingT, backoff assert.Backoff, block func(c assert.TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ConsistentlyContext(ctx, assert.Assume(t), backoff, block)
+}
+
+// ThatCode runs the given function and starts assumptions on whether it panics.
+func ThatCode(t assert.TestingT, code func()) *assert.CodeAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatCode(assert.Assume(t), code)
+}
@@ -0,0 +1,104 @@
+package assume_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/skhome/assertg/assume"
+)
+
+type fixtureT struct {
+	message string
+	skipped bool
+	failed  bool
+}
+
+func (f *fixtureT) Errorf(format string, args ...any) {
+	f.message = fmt.Sprintf(format, args...)
+	f.failed = true
+}
+
+func (f *fixtureT) Skipf(format string, args ...any) {
+	f.message = fmt.Sprintf(format, args...)
+	f.skipped = true
+}
+
+func (f *fixtureT) Helper() {}
+
+func assertSkipped(t *testing.T, fixture *fixtureT, message string) {
+	t.Helper()
+	if !fixture.skipped || fixture.failed {
+		t.Errorf("expected test to be skipped and not failed, but got skipped=%t failed=%t", fixture.skipped, fixture.failed)
+	}
+	if !strings.Contains(fixture.message, message) {
+		t.Errorf("expected to skip with message %q, but got %#v", message, fixture.message)
+	}
+}
+
+func TestAssumptionsHold(t *testing.T) {
+	fixture := new(fixtureT)
+	assume.ThatString(fixture, "postgres://localhost").IsNotEmpty()
+	assume.ThatSlice(fixture, []int{1, 2}).HasSize(2)
+	assume.ThatBool(fixture, true).IsTrue()
+	assume.ThatInteger(fixture, 42).IsPositive()
+	assume.ThatFloat(fixture, 1.5).IsPositive()
+	assume.ThatError(fixture, nil).IsNil()
+	if fixture.skipped || fixture.failed {
+		t.Errorf("expected test to continue, but got %#v", fixture.message)
+	}
+}
+
+func TestAssumeString(t *testing.T) {
+	fixture := new(fixtureT)
+	assume.ThatString(fixture, "").DescribedAs("DB_URL").IsNotEmpty()
+	assertSkipped(t, fixture, "[DB_URL] expected string to not be empty, but got <>")
+}
+
+func TestAssumeSlice(t *testing.T) {
+	fixture := new(fixtureT)
+	assume.ThatSlice(fixture, []string{}).IsNotEmpty()
+	assertSkipped(t, fixture, "expected slice to not be empty, but got <[]>")
+}
+
+func TestAssumeBool(t *testing.T) {
+	fixture := new(fixtureT)
+	assume.ThatBool(fixture, false).IsTrue()
+	assertSkipped(t, fixture, "expected value to be true, but got <false>")
+}
+
+func TestAssumeInteger(t *testing.T) {
+	fixture := new(fixtureT)
+	assume.ThatInteger(fixture, 2).IsOdd()
+	assertSkipped(t, fixture, "expected value to be odd, but got <2>")
+}
+
+func TestAssumeFloat(t *testing.T) {
+	fixture := new(fixtureT)
+	assume.ThatFloat(fixture, 0.0).IsPositive()
+	assertSkipped(t, fixture, "expected value to be positive, but got <0>")
+}
+
+func TestAssumeError(t *testing.T) {
+	fixture := new(fixtureT)
+	assume.ThatError(fixture, errors.New("offline")).IsNil()
+	assertSkipped(t, fixture, "expected error to be nil, but got <offline>")
+}
+
+type errorOnlyT struct {
+	message string
+}
+
+func (e *errorOnlyT) Errorf(format string, args ...any) {
+	e.message = fmt.Sprintf(format, args...)
+}
+
+func TestAssumeWithoutSkipf(t *testing.T) {
+	fixture := new(errorOnlyT)
+	assume.ThatString(fixture, "").IsNotEmpty()
+	expected := "expected string to not be empty, but got <>\n(the test could not be skipped, it has no Skipf method)"
+	if fixture.message != expected {
+		t.Errorf("expected to fail with error message %q, but got %#v", expected, fixture.message)
+	}
+}
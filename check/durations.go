@@ -0,0 +1,33 @@
+package check
+
+import (
+	"math"
+	"time"
+)
+
+// DurationIsBetween returns if a duration is not shorter than the start and not longer than the end duration.
+func DurationIsBetween(value, start, end time.Duration) bool {
+	return start <= value && value <= end
+}
+
+// DurationIsCloseTo returns if two durations differ by at most the given tolerance.
+func DurationIsCloseTo(a, b, tolerance time.Duration) bool {
+	diff := a - b
+	return -tolerance <= diff && diff <= tolerance
+}
+
+// DurationIsCloseToPercentage returns if a duration differs from the expected one by at most the given percentage
+// of the expected duration.
+func DurationIsCloseToPercentage(value, expected time.Duration, percentage float64) bool {
+	return DurationIsCloseTo(value, expected, DurationPercentage(expected, percentage))
+}
+
+// DurationPercentage returns the given percentage of a duration, ignoring its sign.
+func DurationPercentage(d time.Duration, percentage float64) time.Duration {
+	return time.Duration(math.Abs(float64(d) * percentage / 100))
+}
+
+// DurationIsRoundedTo returns if a duration is a multiple of the given unit.
+func DurationIsRoundedTo(d, unit time.Duration) bool {
+	return unit > 0 && d%unit == 0
+}
@@ -0,0 +1,191 @@
+package check
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// maxErrorChainLength is the maximum number of errors followed in the chain of an error.
+const maxErrorChainLength = 100
+
+// maxErrorTreeSize is the maximum number of errors visited in the tree of an error.
+const maxErrorTreeSize = 1000
+
+// ErrorChain returns the error and all errors it wraps by following errors.Unwrap,
+// starting with the error itself. The chain is empty for a nil error.
+// The chain ends before an error that is already part of it, so an error unwrapping to itself or to an error
+// wrapping it does not loop forever, and it is cut off after 100 errors.
+func ErrorChain(err error) []error {
+	var chain []error
+	for ; err != nil && len(chain) < maxErrorChainLength && !errorIsIn(err, chain); err = errors.Unwrap(err) {
+		chain = append(chain, err)
+	}
+	return chain
+}
+
+// errorIsIn returns whether an error is equal to any of the given errors. Errors that are not comparable
+// are never equal.
+func errorIsIn(err error, errs []error) bool {
+	if !reflect.ValueOf(err).Comparable() {
+		return false
+	}
+	for _, e := range errs {
+		if reflect.ValueOf(e).Comparable() && e == err {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrorOwnMessage returns the part of an error message that the error adds to the message of the error it wraps,
+// e.g. "open config" for "open config: permission denied" wrapping "permission denied".
+// The whole message is returned if the error does not end with the message of the wrapped error.
+func ErrorOwnMessage(err error, wrapped error) string {
+	message := err.Error()
+	if wrapped == nil {
+		return message
+	}
+	own, found := strings.CutSuffix(message, wrapped.Error())
+	if !found {
+		return message
+	}
+	return strings.TrimSpace(strings.TrimRight(strings.TrimSpace(own), ":"))
+}
+
+// ErrorOwnMessages returns the own message of each error in the chain of an error.
+func ErrorOwnMessages(err error) []string {
+	chain := ErrorChain(err)
+	messages := make([]string, len(chain))
+	for i, layer := range chain {
+		var wrapped error
+		if i+1 < len(chain) {
+			wrapped = chain[i+1]
+		}
+		messages[i] = ErrorOwnMessage(layer, wrapped)
+	}
+	return messages
+}
+
+// ErrorChildren returns the errors directly wrapped by an error,
+// supporting both an Unwrap() error and an Unwrap() []error method.
+func ErrorChildren(err error) []error {
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		return e.Unwrap()
+	case interface{ Unwrap() error }:
+		if wrapped := e.Unwrap(); wrapped != nil {
+			return []error{wrapped}
+		}
+	}
+	return nil
+}
+
+// ErrorIsJoined returns whether an error combines multiple errors with an Unwrap() []error method, like errors.Join.
+func ErrorIsJoined(err error) bool {
+	_, ok := err.(interface{ Unwrap() []error })
+	return ok
+}
+
+// ErrorLeafErrors returns the leaf errors of an error. The leaf errors are the errors combined by the first
+// joined error in the chain of the error, like errors.Join, with nested joined errors replaced by their leaf errors.
+// Combined errors that wrap a joined error, e.g. with fmt.Errorf, are leaf errors themselves.
+// An error that does not wrap a joined error is its only leaf error, a nil error has no leaf errors.
+func ErrorLeafErrors(err error) []error {
+	for _, e := range ErrorChain(err) {
+		if ErrorIsJoined(e) {
+			return joinedLeafErrors(e)
+		}
+	}
+	if err == nil {
+		return nil
+	}
+	return []error{err}
+}
+
+// joinedLeafErrors returns the errors combined by a joined error, flattening directly nested joined errors.
+func joinedLeafErrors(err error) []error {
+	var errs []error
+	WalkErrorTree(err, func(e error, depth int) bool {
+		if depth == 0 || ErrorIsJoined(e) {
+			return true
+		}
+		errs = append(errs, e)
+		return false
+	})
+	return errs
+}
+
+// ErrorTree returns the error and all errors in its tree, following both single and joined wrapped errors,
+// depth first with each error before the errors it wraps. The joined errors themselves are part of the tree.
+// The tree is empty for a nil error, see WalkErrorTree for the handling of cyclic errors.
+func ErrorTree(err error) []error {
+	var errs []error
+	WalkErrorTree(err, func(e error, _ int) bool {
+		errs = append(errs, e)
+		return true
+	})
+	return errs
+}
+
+// WalkErrorTree calls visit for an error and each error in its tree, following both single and joined wrapped
+// errors, depth first with the depth of each error starting at 0. The errors wrapped by an error are only visited
+// if visit returns true for it. An error that is already on the path to it is skipped, so an error wrapping itself
+// does not recurse forever, and the walk is cut off below a depth of 100 and after 1000 errors.
+func WalkErrorTree(err error, visit func(e error, depth int) bool) {
+	visited := 0
+	var walk func(e error, path []error)
+	walk = func(e error, path []error) {
+		if e == nil || len(path) >= maxErrorChainLength || visited >= maxErrorTreeSize || errorIsIn(e, path) {
+			return
+		}
+		visited++
+		if !visit(e, len(path)) {
+			return
+		}
+		path = append(path, e)
+		for _, child := range ErrorChildren(e) {
+			walk(child, path)
+		}
+	}
+	walk(err, nil)
+}
+
+// ErrorTreeContains returns whether an error or any error in its tree, following both single and joined
+// wrapped errors, matches the given predicate. The tree is walked with WalkErrorTree, so cyclic errors end the walk.
+func ErrorTreeContains(err error, predicate Predicate[error]) bool {
+	found := false
+	WalkErrorTree(err, func(e error, _ int) bool {
+		found = found || predicate(e)
+		return !found
+	})
+	return found
+}
+
+// ErrorIsTimeout returns whether any error in the tree of an error reports a timeout with a Timeout() bool method,
+// like net.Error, os.ErrDeadlineExceeded or context.DeadlineExceeded.
+func ErrorIsTimeout(err error) bool {
+	return ErrorTreeContains(err, func(e error) bool {
+		timeout, ok := e.(interface{ Timeout() bool })
+		return ok && timeout.Timeout()
+	})
+}
+
+// ErrorIsTemporary returns whether any error in the tree of an error reports a temporary condition
+// with a Temporary() bool method.
+func ErrorIsTemporary(err error) bool {
+	return ErrorTreeContains(err, func(e error) bool {
+		temporary, ok := e.(interface{ Temporary() bool })
+		return ok && temporary.Temporary()
+	})
+}
+
+// ErrorIsAnyOf returns whether any of the given errors is in the tree of an error according to errors.Is.
+func ErrorIsAnyOf(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
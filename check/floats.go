@@ -1,6 +1,12 @@
 package check
 
-import "golang.org/x/exp/constraints"
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"golang.org/x/exp/constraints"
+)
 
 // FloatsAreEqual returns wether both values are equal.
 func FloatsAreEqual[T constraints.Float](a, b T) bool {
@@ -31,3 +37,107 @@ func FloatIsLessThanOrEqualTo[T constraints.Float](a, b T) bool {
 func FloatIsBetween[T constraints.Float](value, start, end T) bool {
 	return (start <= value) && (value <= end)
 }
+
+// Tolerance describes by how much a float value may differ from an expected value.
+type Tolerance interface {
+	// Allowed returns the allowed absolute difference from the expected value.
+	Allowed(expected float64) float64
+}
+
+// Offset is an absolute tolerance.
+//
+//	assert.ThatFloat(t, math.Sqrt(2)*math.Sqrt(2)).IsCloseTo(2, check.Offset(1e-9))
+type Offset float64
+
+// Allowed returns the offset as allowed difference, regardless of the expected value.
+func (o Offset) Allowed(float64) float64 {
+	return math.Abs(float64(o))
+}
+
+// Percentage is a tolerance relative to the expected value, given in percent.
+//
+//	assert.ThatFloat(t, 99.6).IsCloseTo(100, check.Percentage(0.5))
+type Percentage float64
+
+// Allowed returns the percentage of the expected value as allowed difference.
+func (p Percentage) Allowed(expected float64) float64 {
+	return math.Abs(expected * float64(p) / 100)
+}
+
+// String returns the percentage followed by a percent sign.
+func (p Percentage) String() string {
+	return fmt.Sprintf("%v%%", float64(p))
+}
+
+// FloatIsCloseTo returns whether a float value differs from the expected value by at most the given tolerance.
+// Infinite values are only close to the same infinity, NaN is never close to any value.
+func FloatIsCloseTo[T constraints.Float](value, expected T, tolerance Tolerance) bool {
+	if value == expected {
+		return true
+	}
+	return math.Abs(float64(value)-float64(expected)) <= tolerance.Allowed(float64(expected))
+}
+
+// FloatULPDistance returns the number of representable float values between two float values.
+// The distance is the maximum uint64 if either value is NaN.
+func FloatULPDistance[T constraints.Float](a, b T) uint64 {
+	if a != a || b != b {
+		return math.MaxUint64
+	}
+	x, y := floatOrderedBits(a), floatOrderedBits(b)
+	if x < y {
+		x, y = y, x
+	}
+	return uint64(x) - uint64(y)
+}
+
+// FloatIsWithinULPs returns whether two float values are at most the given number of representable values apart.
+func FloatIsWithinULPs[T constraints.Float](value, expected T, ulps uint64) bool {
+	return value == expected || FloatULPDistance(value, expected) <= ulps
+}
+
+// floatOrderedBits maps a float value to an integer that is ordered like the float values,
+// so adjacent float values map to adjacent integers.
+func floatOrderedBits[T constraints.Float](f T) int64 {
+	bits := FloatBits(f)
+	signBit := uint64(1) << 63
+	if reflect.TypeOf(f).Kind() == reflect.Float32 {
+		signBit = 1 << 31
+	}
+	if bits&signBit != 0 {
+		return -int64(bits &^ signBit)
+	}
+	return int64(bits)
+}
+
+// FloatIsNaN returns whether a float value is not a number.
+func FloatIsNaN[T constraints.Float](value T) bool {
+	return math.IsNaN(float64(value))
+}
+
+// FloatIsInf returns whether a float value is an infinity, according to sign.
+// If sign > 0, it returns whether the value is positive infinity.
+// If sign < 0, it returns whether the value is negative infinity.
+// If sign == 0, it returns whether the value is either infinity.
+func FloatIsInf[T constraints.Float](value T, sign int) bool {
+	return math.IsInf(float64(value), sign)
+}
+
+// FloatIsFinite returns whether a float value is neither an infinity nor NaN.
+func FloatIsFinite[T constraints.Float](value T) bool {
+	return !FloatIsNaN(value) && !FloatIsInf(value, 0)
+}
+
+// FloatIsNegativeZero returns whether a float value is negative zero.
+func FloatIsNegativeZero[T constraints.Float](value T) bool {
+	return value == 0 && math.Signbit(float64(value))
+}
+
+// FloatBits returns the IEEE 754 binary representation of a float value,
+// using 32 bits for float32 values and 64 bits otherwise.
+func FloatBits[T constraints.Float](value T) uint64 {
+	if reflect.TypeOf(value).Kind() == reflect.Float32 {
+		return uint64(math.Float32bits(float32(value)))
+	}
+	return math.Float64bits(float64(value))
+}
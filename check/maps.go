@@ -0,0 +1,103 @@
+package check
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// MapHasSize returns if the map has the given size.
+func MapHasSize[M ~map[K]V, K comparable, V any](m M, size int) bool {
+	return len(m) == size
+}
+
+// MapContainsKey returns if the map contains the given key.
+func MapContainsKey[M ~map[K]V, K comparable, V any](m M, key K) bool {
+	_, ok := m[key]
+	return ok
+}
+
+// MapContainsEntry returns if the map contains the given key with a value equal to the given one.
+func MapContainsEntry[M ~map[K]V, K comparable, V any](m M, key K, value V) bool {
+	actual, ok := m[key]
+	return ok && ObjectsAreEqual(actual, value)
+}
+
+// MapContainsValue returns if the map contains the given value for any key.
+func MapContainsValue[M ~map[K]V, K comparable, V any](m M, value V) bool {
+	for _, v := range m {
+		if ObjectsAreEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// MapMatchPredicateCount returns how many values in the map match the given predicate.
+func MapMatchPredicateCount[M ~map[K]V, K comparable, V any](m M, predicate Predicate[V]) int {
+	matches := 0
+	for _, v := range m {
+		if predicate(v) {
+			matches++
+		}
+	}
+	return matches
+}
+
+// MapSortedKeys returns the keys of the map in a deterministic order, see CompareValues.
+func MapSortedKeys[M ~map[K]V, K comparable, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b K) int { return CompareValues(a, b) })
+	return keys
+}
+
+// MapSortedValues returns the values of the map in a deterministic order, see CompareValues.
+func MapSortedValues[M ~map[K]V, K comparable, V any](m M) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	slices.SortFunc(values, func(a, b V) int { return CompareValues(a, b) })
+	return values
+}
+
+// CompareValues compares two values of the same type for a deterministic ordering.
+// Numbers, strings and booleans are compared by value and all other values by their default textual representation.
+// Pointers and channels with the same textual representation are ordered by address, so the order is total,
+// but only deterministic across runs for values that can be told apart by their representation.
+func CompareValues(a, b any) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() || va.Kind() != vb.Kind() {
+		return cmp.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b))
+	}
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(va.Int(), vb.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(va.Uint(), vb.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(va.Float(), vb.Float())
+	case reflect.String:
+		return cmp.Compare(va.String(), vb.String())
+	case reflect.Bool:
+		switch {
+		case va.Bool() == vb.Bool():
+			return 0
+		case vb.Bool():
+			return -1
+		default:
+			return 1
+		}
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		if c := cmp.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(va.Pointer(), vb.Pointer())
+	default:
+		return cmp.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+	}
+}
@@ -25,3 +25,52 @@ func ObjectsAreEqual(expected, actual interface{}) bool {
 	}
 	return bytes.Equal(exp, act)
 }
+
+// ObjectIsNil returns whether a value is nil, including typed nil pointers, maps, slices, channels
+// and functions stored in an interface value.
+func ObjectIsNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// ObjectIsZero returns whether a value is the zero value of its type.
+func ObjectIsZero[T any](value T) bool {
+	return reflect.ValueOf(&value).Elem().IsZero()
+}
+
+// ObjectsAreSame returns whether two values of the same type refer to the same instance.
+// Pointers, maps and channels are the same if they point to the same address, slices if they also have
+// the same length. Functions have no identity, closures of the same function literal share their code address,
+// so they are never the same, like other values that are no references.
+func ObjectsAreSame(a, b any) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() || va.Type() != vb.Type() {
+		return false
+	}
+	switch va.Kind() {
+	case reflect.Chan, reflect.Map, reflect.Pointer, reflect.UnsafePointer:
+		return va.Pointer() == vb.Pointer()
+	case reflect.Slice:
+		return va.Pointer() == vb.Pointer() && va.Len() == vb.Len()
+	default:
+		return false
+	}
+}
+
+// ObjectIsIn returns whether a value is equal to any of the given values.
+func ObjectIsIn[T any](value T, values []T) bool {
+	for _, v := range values {
+		if ObjectsAreEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
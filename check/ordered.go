@@ -0,0 +1,36 @@
+package check
+
+// Comparator compares two values and returns a negative number if a is less than b,
+// a positive number if a is greater than b and zero if both are equal, like cmp.Compare.
+type Comparator[T any] func(a, b T) int
+
+// OrderedIsBetween returns whether a value is greater than or equal to the start and less than or equal to the end value.
+func OrderedIsBetween[T any](compare Comparator[T], value, start, end T) bool {
+	return compare(start, value) <= 0 && compare(value, end) <= 0
+}
+
+// OrderedIsStrictlyBetween returns whether a value is greater than the start and less than the end value.
+func OrderedIsStrictlyBetween[T any](compare Comparator[T], value, start, end T) bool {
+	return compare(start, value) < 0 && compare(value, end) < 0
+}
+
+// OrderedIsIn returns whether a value compares equal to any of the given values.
+func OrderedIsIn[T any](compare Comparator[T], value T, values []T) bool {
+	for _, v := range values {
+		if compare(value, v) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderedClamp returns the value limited to the range from lower to upper.
+func OrderedClamp[T any](compare Comparator[T], value, lower, upper T) T {
+	if compare(value, lower) < 0 {
+		return lower
+	}
+	if compare(value, upper) > 0 {
+		return upper
+	}
+	return value
+}
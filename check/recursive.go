@@ -0,0 +1,336 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"unsafe"
+)
+
+// DefaultDifferenceFormat is the format of a difference between an expected and an actual value.
+const DefaultDifferenceFormat = "expected %[1]s but got %[2]s"
+
+// Difference describes a difference found by a recursive comparison.
+type Difference struct {
+	// Path is the location of the difference, e.g. Lines[2].Price, or empty for the compared values themselves.
+	Path string
+	// Expected is the expected value at the path.
+	Expected any
+	// Actual is the actual value at the path.
+	Actual any
+	// Format describes the difference, referencing the expected value as %[1]s and the actual value as %[2]s.
+	Format string
+}
+
+// RecursiveComparison configures a field by field comparison of structs, pointers, slices, arrays and maps.
+//
+// Field paths are given without indexes or map keys, so Lines.Price refers to the price of all lines.
+type RecursiveComparison struct {
+	// IgnoredFields are the paths of fields that are not compared.
+	IgnoredFields []string
+	// IgnoredFieldsMatching are patterns of field paths that are not compared.
+	IgnoredFieldsMatching []*regexp.Regexp
+	// IgnoreUnexported ignores all unexported struct fields.
+	IgnoreUnexported bool
+	// IgnoreCollectionOrder compares slices and arrays ignoring the order of their elements.
+	IgnoreCollectionOrder bool
+	// ComparedFields are the paths of the only fields that are compared, if not empty.
+	ComparedFields []string
+}
+
+// Compare compares the actual value field by field with the expected one and returns all differences.
+func (c RecursiveComparison) Compare(actual, expected any) []Difference {
+	comparer := &recursiveComparer{config: c, visited: make(map[visit]bool)}
+	comparer.compare("", "", addressable(reflect.ValueOf(actual)), addressable(reflect.ValueOf(expected)))
+	return comparer.differences
+}
+
+// addressable returns an addressable copy of a value, so the values of its unexported fields can be made accessible.
+func addressable(v reflect.Value) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+	return copied
+}
+
+// accessible returns the value itself if it can be used as an interface, or an accessible value at the same
+// address if it was obtained through unexported fields. A value that is not addressable, e.g. a map value,
+// is returned as is.
+func accessible(v reflect.Value) reflect.Value {
+	if v.CanInterface() || !v.CanAddr() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// ObjectsAreEqualRecursively returns if both values are equal field by field.
+func ObjectsAreEqualRecursively(actual, expected any, comparison RecursiveComparison) bool {
+	return len(comparison.Compare(actual, expected)) == 0
+}
+
+// visit records a pair of compared pointers to detect cycles.
+type visit struct {
+	actual   uintptr
+	expected uintptr
+	typ      reflect.Type
+}
+
+type recursiveComparer struct {
+	config      RecursiveComparison
+	visited     map[visit]bool
+	differences []Difference
+}
+
+// compare compares two values at the given path, where field is the path without indexes and map keys.
+func (c *recursiveComparer) compare(path, field string, actual, expected reflect.Value) {
+	if !c.isCompared(field) {
+		return
+	}
+	if !actual.IsValid() || !expected.IsValid() {
+		if actual.IsValid() != expected.IsValid() {
+			c.report(path, actual, expected, DefaultDifferenceFormat)
+		}
+		return
+	}
+	if actual.Type() != expected.Type() {
+		c.differences = append(c.differences, Difference{
+			Path:     path,
+			Expected: expected.Type(),
+			Actual:   actual.Type(),
+			Format:   "expected type %[1]s but got %[2]s",
+		})
+		return
+	}
+	if equal, ok := compareUsingEqualMethod(actual, expected); ok {
+		if !equal {
+			c.report(path, actual, expected, DefaultDifferenceFormat)
+		}
+		return
+	}
+	switch actual.Kind() {
+	case reflect.Pointer:
+		if actual.IsNil() || expected.IsNil() {
+			if actual.IsNil() != expected.IsNil() {
+				c.report(path, actual, expected, DefaultDifferenceFormat)
+			}
+			return
+		}
+		v := visit{actual.Pointer(), expected.Pointer(), actual.Type()}
+		if actual.Pointer() == expected.Pointer() || c.visited[v] {
+			return
+		}
+		c.visited[v] = true
+		c.compare(path, field, actual.Elem(), expected.Elem())
+	case reflect.Interface:
+		c.compare(path, field, actual.Elem(), expected.Elem())
+	case reflect.Struct:
+		for i := 0; i < actual.NumField(); i++ {
+			structField := actual.Type().Field(i)
+			if !structField.IsExported() && c.config.IgnoreUnexported {
+				continue
+			}
+			c.compare(joinPath(path, structField.Name), joinPath(field, structField.Name), actual.Field(i), expected.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		if actual.Kind() == reflect.Slice && (actual.IsNil() || expected.IsNil()) {
+			if actual.IsNil() != expected.IsNil() {
+				c.report(path, actual, expected, DefaultDifferenceFormat)
+			}
+			return
+		}
+		if c.config.IgnoreCollectionOrder {
+			c.compareInAnyOrder(path, field, actual, expected)
+		} else {
+			c.compareInOrder(path, field, actual, expected)
+		}
+	case reflect.Map:
+		if actual.IsNil() || expected.IsNil() {
+			if actual.IsNil() != expected.IsNil() {
+				c.report(path, actual, expected, DefaultDifferenceFormat)
+			}
+			return
+		}
+		c.compareMaps(path, field, actual, expected)
+	default:
+		if !leafValuesAreEqual(actual, expected) {
+			c.report(path, actual, expected, DefaultDifferenceFormat)
+		}
+	}
+}
+
+// compareInOrder compares the elements of two slices or arrays index by index.
+func (c *recursiveComparer) compareInOrder(path, field string, actual, expected reflect.Value) {
+	for i := 0; i < max(actual.Len(), expected.Len()); i++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= actual.Len():
+			c.report(elementPath, reflect.Value{}, expected.Index(i), "expected %[1]s but got no element")
+		case i >= expected.Len():
+			c.report(elementPath, actual.Index(i), reflect.Value{}, "expected no element but got %[2]s")
+		default:
+			c.compare(elementPath, field, actual.Index(i), expected.Index(i))
+		}
+	}
+}
+
+// compareInAnyOrder compares the elements of two slices or arrays ignoring their order.
+func (c *recursiveComparer) compareInAnyOrder(path, field string, actual, expected reflect.Value) {
+	matched := make([]bool, actual.Len())
+	for i := 0; i < expected.Len(); i++ {
+		found := false
+		for j := 0; j < actual.Len(); j++ {
+			if matched[j] {
+				continue
+			}
+			if c.isEqual(field, actual.Index(j), expected.Index(i)) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.report(path, reflect.Value{}, expected.Index(i), "expected element %[1]s but it was missing")
+		}
+	}
+	for j := 0; j < actual.Len(); j++ {
+		if !matched[j] {
+			c.report(path, actual.Index(j), reflect.Value{}, "expected no element but got %[2]s")
+		}
+	}
+}
+
+// compareMaps compares the entries of two maps in the order of their keys.
+func (c *recursiveComparer) compareMaps(path, field string, actual, expected reflect.Value) {
+	keys := actual.MapKeys()
+	for _, key := range expected.MapKeys() {
+		if !actual.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b reflect.Value) int { return CompareValues(valueOf(a), valueOf(b)) })
+	for _, key := range keys {
+		entryPath := fmt.Sprintf("%s[%v]", path, key)
+		actualValue, expectedValue := actual.MapIndex(key), expected.MapIndex(key)
+		switch {
+		case !actualValue.IsValid():
+			c.report(entryPath, reflect.Value{}, expectedValue, "expected %[1]s but got no entry")
+		case !expectedValue.IsValid():
+			c.report(entryPath, actualValue, reflect.Value{}, "expected no entry but got %[2]s")
+		default:
+			c.compare(entryPath, field, actualValue, expectedValue)
+		}
+	}
+}
+
+// isEqual returns if two values are equal without recording their differences.
+func (c *recursiveComparer) isEqual(field string, actual, expected reflect.Value) bool {
+	nested := &recursiveComparer{config: c.config, visited: make(map[visit]bool)}
+	nested.compare("", field, actual, expected)
+	return len(nested.differences) == 0
+}
+
+// isCompared returns if the field with the given path is compared.
+func (c *recursiveComparer) isCompared(field string) bool {
+	if field == "" {
+		return true
+	}
+	for _, ignored := range c.config.IgnoredFields {
+		if isSameOrNestedField(field, ignored) {
+			return false
+		}
+	}
+	for _, re := range c.config.IgnoredFieldsMatching {
+		if re.MatchString(field) {
+			return false
+		}
+	}
+	if len(c.config.ComparedFields) == 0 {
+		return true
+	}
+	for _, compared := range c.config.ComparedFields {
+		if isSameOrNestedField(field, compared) || isSameOrNestedField(compared, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// report records a difference between two values.
+func (c *recursiveComparer) report(path string, actual, expected reflect.Value, format string) {
+	c.differences = append(c.differences, Difference{
+		Path:     path,
+		Expected: valueOf(expected),
+		Actual:   valueOf(actual),
+		Format:   format,
+	})
+}
+
+// isSameOrNestedField returns if the field is the same as or nested in the parent field.
+func isSameOrNestedField(field, parent string) bool {
+	return field == parent || strings.HasPrefix(field, parent+".")
+}
+
+// joinPath appends a field name to a path.
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// valueOf returns the value held by v, or v itself if it cannot be accessed, e.g. for unexported map values.
+func valueOf(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	if v = accessible(v); v.CanInterface() {
+		return v.Interface()
+	}
+	return v
+}
+
+// compareUsingEqualMethod compares two values using their Equal method, if they have one.
+// Values of unexported fields are compared with their Equal method as well, as long as they are addressable.
+func compareUsingEqualMethod(actual, expected reflect.Value) (equal bool, ok bool) {
+	actual, expected = accessible(actual), accessible(expected)
+	if !actual.CanInterface() || !expected.CanInterface() {
+		return false, false
+	}
+	method, found := actual.Type().MethodByName("Equal")
+	if !found || method.Type.NumIn() != 2 || method.Type.In(1) != actual.Type() ||
+		method.Type.NumOut() != 1 || method.Type.Out(0).Kind() != reflect.Bool {
+		return false, false
+	}
+	if actual.Kind() == reflect.Pointer && (actual.IsNil() || expected.IsNil()) {
+		return false, false
+	}
+	return actual.Method(method.Index).Call([]reflect.Value{expected})[0].Bool(), true
+}
+
+// leafValuesAreEqual compares two values of the same type that have no nested values.
+func leafValuesAreEqual(actual, expected reflect.Value) bool {
+	switch actual.Kind() {
+	case reflect.Bool:
+		return actual.Bool() == expected.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return actual.Int() == expected.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return actual.Uint() == expected.Uint()
+	case reflect.Float32, reflect.Float64:
+		return actual.Float() == expected.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return actual.Complex() == expected.Complex()
+	case reflect.String:
+		return actual.String() == expected.String()
+	case reflect.Func:
+		return actual.IsNil() && expected.IsNil()
+	case reflect.Chan, reflect.UnsafePointer:
+		return actual.Pointer() == expected.Pointer()
+	default:
+		return false
+	}
+}
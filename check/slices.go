@@ -83,3 +83,88 @@ func SliceMatchPredicateCount[T ~[]E, E any](slice T, predicate Predicate[E]) in
 func SliceHasPrecicateMatches[T ~[]E, E any](slice T, predicate Predicate[E], times int) bool {
 	return SliceMatchPredicateCount(slice, predicate) == times
 }
+
+// SliceDifference returns the elements of expected that are missing in the slice and the elements of the slice
+// that are not expected, respecting the number of occurrences of each element.
+func SliceDifference[T ~[]E, E any](slice T, expected T) (missing []E, unexpected []E) {
+	matched := make([]bool, len(slice))
+	for _, element := range expected {
+		found := false
+		for i := range slice {
+			if !matched[i] && ObjectsAreEqual(slice[i], element) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, element)
+		}
+	}
+	for i := range slice {
+		if !matched[i] {
+			unexpected = append(unexpected, slice[i])
+		}
+	}
+	return missing, unexpected
+}
+
+// SliceDistinctDifference returns the elements of expected that are not contained in the slice and the elements
+// of the slice that are not contained in expected, ignoring duplicates.
+func SliceDistinctDifference[T ~[]E, E any](slice T, expected T) (missing []E, unexpected []E) {
+	for _, element := range expected {
+		if !SliceContainsEntry(slice, element) && !SliceContainsEntry(missing, element) {
+			missing = append(missing, element)
+		}
+	}
+	for _, element := range slice {
+		if !SliceContainsEntry(expected, element) && !SliceContainsEntry(unexpected, element) {
+			unexpected = append(unexpected, element)
+		}
+	}
+	return missing, unexpected
+}
+
+// SliceFirstMismatch returns the first index at which both slices differ, or -1 if they are equal.
+func SliceFirstMismatch[T ~[]E, E any](a T, b T) int {
+	for i := 0; i < min(len(a), len(b)); i++ {
+		if !ObjectsAreEqual(a[i], b[i]) {
+			return i
+		}
+	}
+	if len(a) != len(b) {
+		return min(len(a), len(b))
+	}
+	return -1
+}
+
+// SliceUnmatchedRequirements assigns each requirement to a distinct element, where matches[i][j] tells whether
+// requirement i is met by element j. It returns the indices of the requirements that are left without an element
+// by an assignment that matches as many requirements as possible.
+func SliceUnmatchedRequirements(matches [][]bool, elements int) []int {
+	assigned := make([]int, elements)
+	for j := range assigned {
+		assigned[j] = -1
+	}
+	var assign func(requirement int, visited []bool) bool
+	assign = func(requirement int, visited []bool) bool {
+		for j := 0; j < elements; j++ {
+			if !matches[requirement][j] || visited[j] {
+				continue
+			}
+			visited[j] = true
+			if assigned[j] < 0 || assign(assigned[j], visited) {
+				assigned[j] = requirement
+				return true
+			}
+		}
+		return false
+	}
+	var unmatched []int
+	for i := range matches {
+		if !assign(i, make([]bool, elements)) {
+			unmatched = append(unmatched, i)
+		}
+	}
+	return unmatched
+}
@@ -0,0 +1,40 @@
+package check
+
+import "time"
+
+// TimeIsBetween returns if a time is not before the start and not after the end time.
+func TimeIsBetween(value, start, end time.Time) bool {
+	return !value.Before(start) && !value.After(end)
+}
+
+// TimeIsCloseTo returns if two times differ by at most the given tolerance.
+func TimeIsCloseTo(a, b time.Time, tolerance time.Duration) bool {
+	diff := a.Sub(b)
+	return -tolerance <= diff && diff <= tolerance
+}
+
+// TimesAreEqualIgnoringMonotonic returns if two times are the same instant in the same location,
+// ignoring their monotonic clock readings. Locations are compared by name.
+func TimesAreEqualIgnoringMonotonic(a, b time.Time) bool {
+	return a.Equal(b) && a.Location().String() == b.Location().String()
+}
+
+// TimesAreInSameMonth returns if two times are in the same month, in the location of the first time.
+func TimesAreInSameMonth(a, b time.Time) bool {
+	b = b.In(a.Location())
+	return a.Year() == b.Year() && a.Month() == b.Month()
+}
+
+// TimesAreInSameDay returns if two times are on the same day, in the location of the first time.
+func TimesAreInSameDay(a, b time.Time) bool {
+	b = b.In(a.Location())
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// TimesAreInSameHour returns if two times are in the same hour, in the location of the first time.
+// The hour is the instant range starting at the wall clock hour of the first time, so a repeated
+// wall clock hour on a daylight saving transition is a different hour.
+func TimesAreInSameHour(a, b time.Time) bool {
+	start := a.Add(-time.Duration(a.Minute())*time.Minute - time.Duration(a.Second())*time.Second - time.Duration(a.Nanosecond()))
+	return !b.Before(start) && b.Before(start.Add(time.Hour))
+}
@@ -0,0 +1,131 @@
+package check
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// FloatsWorstMismatch compares two float slices of the same length element by element.
+// It returns the number of elements that are not close to the expected elements within the given tolerance
+// and the index of the one with the largest difference, or -1 if all elements are close.
+func FloatsWorstMismatch[T constraints.Float](actual, expected []T, tolerance Tolerance) (index int, count int) {
+	index = -1
+	worst := 0.0
+	for i := range actual {
+		if FloatIsCloseTo(actual[i], expected[i], tolerance) {
+			continue
+		}
+		count++
+		if diff := floatDifference(actual[i], expected[i]); index < 0 || isLargerDifference(diff, worst) {
+			index, worst = i, diff
+		}
+	}
+	return index, count
+}
+
+// FloatsMonotonicityViolation returns the index of the first element that breaks the order of a float slice,
+// or -1 if the slice is ordered. The slice is expected to be non-decreasing if increasing is true
+// and non-increasing otherwise. NaN values always break the order.
+func FloatsMonotonicityViolation[T constraints.Float](values []T, increasing bool) int {
+	for i := range values {
+		if FloatIsNaN(values[i]) {
+			return i
+		}
+		if i == 0 {
+			continue
+		}
+		if (increasing && values[i] < values[i-1]) || (!increasing && values[i] > values[i-1]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FloatsL2Norm returns the euclidean norm of a float slice.
+func FloatsL2Norm[T constraints.Float](values []T) float64 {
+	norm := 0.0
+	for _, value := range values {
+		norm = math.Hypot(norm, float64(value))
+	}
+	return norm
+}
+
+// MatrixShape returns the number of rows and columns of a matrix, where the number of columns is the length
+// of the first row. It also returns whether all rows have the same length.
+func MatrixShape[T any](matrix [][]T) (rows int, columns int, rectangular bool) {
+	rows = len(matrix)
+	if rows == 0 {
+		return 0, 0, true
+	}
+	columns = len(matrix[0])
+	for _, row := range matrix {
+		if len(row) != columns {
+			return rows, columns, false
+		}
+	}
+	return rows, columns, true
+}
+
+// MatrixWorstMismatch compares two matrices of the same shape element by element.
+// It returns the number of elements that are not close to the expected elements within the given tolerance
+// and the row and column of the one with the largest difference, or -1 and -1 if all elements are close.
+func MatrixWorstMismatch[T constraints.Float](actual, expected [][]T, tolerance Tolerance) (row int, column int, count int) {
+	row, column = -1, -1
+	worst := 0.0
+	for i := range actual {
+		j, rowCount := FloatsWorstMismatch(actual[i], expected[i], tolerance)
+		if rowCount == 0 {
+			continue
+		}
+		count += rowCount
+		if diff := floatDifference(actual[i][j], expected[i][j]); row < 0 || isLargerDifference(diff, worst) {
+			row, column, worst = i, j, diff
+		}
+	}
+	return row, column, count
+}
+
+// MatrixAsymmetry compares each element above the diagonal of a square matrix with the element mirrored
+// at the diagonal, which is taken as the expected element. It returns the number of mirrored pairs that are not
+// close within the given tolerance and the row and column of the element above the diagonal of the pair with the
+// largest difference, or -1 and -1 if the matrix is symmetric.
+func MatrixAsymmetry[T constraints.Float](matrix [][]T, tolerance Tolerance) (row int, column int, count int) {
+	row, column = -1, -1
+	worst := 0.0
+	for i := range matrix {
+		for j := i + 1; j < len(matrix[i]); j++ {
+			if FloatIsCloseTo(matrix[i][j], matrix[j][i], tolerance) {
+				continue
+			}
+			count++
+			if diff := floatDifference(matrix[i][j], matrix[j][i]); row < 0 || isLargerDifference(diff, worst) {
+				row, column, worst = i, j, diff
+			}
+		}
+	}
+	return row, column, count
+}
+
+// MatrixTranspose returns the transpose of a rectangular matrix.
+func MatrixTranspose[T any](matrix [][]T) [][]T {
+	rows, columns, _ := MatrixShape(matrix)
+	transposed := make([][]T, columns)
+	for j := range transposed {
+		transposed[j] = make([]T, rows)
+		for i := range matrix {
+			transposed[j][i] = matrix[i][j]
+		}
+	}
+	return transposed
+}
+
+// floatDifference returns the absolute difference of two float values.
+func floatDifference[T constraints.Float](a, b T) float64 {
+	return math.Abs(float64(a) - float64(b))
+}
+
+// isLargerDifference returns whether a difference is larger than another one, where NaN is the largest difference.
+func isLargerDifference(diff, other float64) bool {
+	return (math.IsNaN(diff) && !math.IsNaN(other)) || diff > other
+}
@@ -0,0 +1,208 @@
+// Package require provides the assertions of the assert package, but stops the test on the first failure.
+// A TestingT without a FailNow method can not be stopped, failures are then reported like in the assert package.
+//
+//	result, err := parse(input)
+//	require.ThatError(t, err).IsNil()
+//
+//	// only reached if err is nil
+//	assert.ThatString(t, result.Name).IsEqualTo("Frodo")
+package require
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+	"golang.org/x/exp/constraints"
+)
+
+// tHelper is a helper interface to signal that this function is a test helper.
+type tHelper interface {
+	Helper()
+}
+
+// ThatString starts assertions on a string, stopping the test on failure.
+func ThatString(t assert.TestingT, actual string) *assert.StringAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatString(assert.Require(t), actual)
+}
+
+// ThatSlice starts assertions on a slice, stopping the test on failure.
+func ThatSlice[T ~[]E, E any](t assert.TestingT, actual T) *assert.SliceAssert[E] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatSlice(assert.Require(t), actual)
+}
+
+// ThatMap starts assertions on a map, stopping the test on failure.
+func ThatMap[M ~map[K]V, K comparable, V any](t assert.TestingT, actual M) *assert.MapAssert[K, V] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatMap(assert.Require(t), actual)
+}
+
+// ThatBool starts assertions on a bool, stopping the test on failure.
+func ThatBool(t assert.TestingT, actual bool) *assert.BoolAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatBool(assert.Require(t), actual)
+}
+
+// ThatInteger starts assertions on an integer, stopping the test on failure.
+func ThatInteger[T constraints.Integer](t assert.TestingT, actual T) *assert.IntegerAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatInteger(assert.Require(t), actual)
+}
+
+// ThatFloat starts assertions on a float, stopping the test on failure.
+func ThatFloat[T constraints.Float](t assert.TestingT, actual T) *assert.FloatAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatFloat(assert.Require(t), actual)
+}
+
+// ThatFloats starts assertions on a slice of floats, stopping the test on failure.
+func ThatFloats[T ~[]E, E constraints.Float](t assert.TestingT, actual T) *assert.FloatsAssert[E] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatFloats(assert.Require(t), actual)
+}
+
+// ThatMatrix starts assertions on a matrix of floats, stopping the test on failure.
+func ThatMatrix[M ~[][]E, E constraints.Float](t assert.TestingT, actual M) *assert.MatrixAssert[E] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatMatrix(assert.Require(t), actual)
+}
+
+// ThatOrdered starts assertions on a value of any ordered type, compared with cmp.Compare, stopping the test on failure.
+func ThatOrdered[T cmp.Ordered](t assert.TestingT, actual T) *assert.OrderedAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatOrdered(assert.Require(t), actual)
+}
+
+// ThatComparable starts assertions on a value that is compared with the given function, stopping the test on failure.
+func ThatComparable[T any](t assert.TestingT, actual T, compare func(a, b T) int) *assert.OrderedAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatComparable(assert.Require(t), actual, compare)
+}
+
+// ThatError starts assertions on an error, stopping the test on failure.
+func ThatError(t assert.TestingT, actual error) *assert.ErrorAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatError(assert.Require(t), actual)
+}
+
+// ErrorAs finds the first error of type T in the chain of the actual error and starts assertions on it,
+// stopping the test on failure.
+func ErrorAs[T error](t assert.TestingT, actual error) *assert.ObjectAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ErrorAs[T](assert.Require(t), actual)
+}
+
+// ThatObject starts assertions on an arbitrary value, stopping the test on failure.
+func ThatObject[T any](t assert.TestingT, actual T) *assert.ObjectAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatObject(assert.Require(t), actual)
+}
+
+// ThatPointer starts assertions on a pointer, stopping the test on failure.
+func ThatPointer[T any](t assert.TestingT, actual *T) *assert.PointerAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatPointer(assert.Require(t), actual)
+}
+
+// ThatResult starts assertions on the value and error returned by a call, stopping the test on failure.
+func ThatResult[T any](t assert.TestingT, actual assert.Result[T]) *assert.ResultAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatResult(assert.Require(t), actual)
+}
+
+// ThatOk starts assertions on the value and ok flag returned by a lookup, stopping the test on failure.
+func ThatOk[T any](t assert.TestingT, actual assert.Lookup[T]) *assert.OkAssert[T] {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatOk(assert.Require(t), actual)
+}
+
+// ThatTime starts assertions on a time, stopping the test on failure.
+func ThatTime(t assert.TestingT, actual time.Time) *assert.TimeAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatTime(assert.Require(t), actual)
+}
+
+// ThatDuration starts assertions on a duration, stopping the test on failure.
+func ThatDuration(t assert.TestingT, actual time.Duration) *assert.DurationAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatDuration(assert.Require(t), actual)
+}
+
+// Eventually verifies that the assertions of the given block pass within the given timeout, stopping the test on failure.
+func Eventually(t assert.TestingT, timeout, interval time.Duration, block func(c assert.TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.Eventually(assert.Require(t), timeout, interval, block)
+}
+
+// EventuallyContext verifies that the assertions of the given block pass before the given context is done, stopping the test on failure.
+func EventuallyContext(ctx context.Context, t assert.TestingT, backoff assert.Backoff, block func(c assert.TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.EventuallyContext(ctx, assert.Require(t), backoff, block)
+}
+
+// Consistently verifies that the assertions of the given block pass for the whole given duration, stopping the test on failure.
+func Consistently(t assert.TestingT, duration, interval time.Duration, block func(c assert.TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.Consistently(assert.Require(t), duration, interval, block)
+}
+
+// ConsistentlyContext verifies that the assertions of the given block pass until the given context is done, stopping the test on failure.
+func ConsistentlyContext(ctx context.Context, t assert.TestingT, backoff assert.Backoff, block func(c assert.TestingT)) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ConsistentlyContext(ctx, assert.Require(t), backoff, block)
+}
+
+// ThatCode runs the given function and starts assertions on whether it panics, stopping the test on failure.
+func ThatCode(t assert.TestingT, code func()) *assert.CodeAssert {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return assert.ThatCode(assert.Require(t), code)
+}
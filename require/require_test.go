@@ -0,0 +1,124 @@
+package require_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skhome/assertg/assert"
+	"github.com/skhome/assertg/require"
+)
+
+type fixtureT struct {
+	message string
+	stopped bool
+}
+
+func (f *fixtureT) Errorf(format string, args ...any) {
+	f.message = fmt.Sprintf(format, args...)
+}
+
+func (f *fixtureT) FailNow() {
+	f.stopped = true
+}
+
+func (f *fixtureT) Helper() {}
+
+func assertStopped(t *testing.T, fixture *fixtureT, message string) {
+	t.Helper()
+	if !fixture.stopped {
+		t.Errorf("expected test to be stopped, but it was not")
+	}
+	if !strings.Contains(fixture.message, message) {
+		t.Errorf("expected to fail with error message %q, but got %#v", message, fixture.message)
+	}
+}
+
+func assertNotStopped(t *testing.T, fixture *fixtureT) {
+	t.Helper()
+	if fixture.stopped || len(fixture.message) > 0 {
+		t.Errorf("expected test not to be stopped, but got %#v", fixture.message)
+	}
+}
+
+func TestRequirePasses(t *testing.T) {
+	fixture := new(fixtureT)
+	require.ThatString(fixture, "Frodo").StartsWith("Fro")
+	require.ThatSlice(fixture, []int{1, 2}).Contains(2)
+	require.ThatBool(fixture, true).IsTrue()
+	require.ThatInteger(fixture, 42).IsPositive()
+	require.ThatFloat(fixture, 1.5).IsBetween(1, 2)
+	require.ThatError(fixture, nil).IsNil()
+	assertNotStopped(t, fixture)
+}
+
+func TestRequireString(t *testing.T) {
+	fixture := new(fixtureT)
+	require.ThatString(fixture, "Frodo").IsEmpty()
+	assertStopped(t, fixture, "expected string to be empty, but got <Frodo>")
+}
+
+func TestRequireSlice(t *testing.T) {
+	fixture := new(fixtureT)
+	require.ThatSlice(fixture, []string{"Sam"}).Contains("Frodo")
+	assertStopped(t, fixture, "expected slice to contain <[Frodo]>, but got <[Sam]>")
+}
+
+func TestRequireBool(t *testing.T) {
+	fixture := new(fixtureT)
+	require.ThatBool(fixture, false).IsTrue()
+	assertStopped(t, fixture, "expected value to be true, but got <false>")
+}
+
+func TestRequireInteger(t *testing.T) {
+	fixture := new(fixtureT)
+	require.ThatInteger(fixture, 1).IsZero()
+	assertStopped(t, fixture, "expected value to be zero, but got <1>")
+}
+
+func TestRequireFloat(t *testing.T) {
+	fixture := new(fixtureT)
+	require.ThatFloat(fixture, 1.5).IsNegative()
+	assertStopped(t, fixture, "expected value to be negative, but got <1.5>")
+}
+
+func TestRequireError(t *testing.T) {
+	fixture := new(fixtureT)
+	require.ThatError(fixture, errors.New("boom")).IsNil()
+	assertStopped(t, fixture, "expected error to be nil, but got <boom>")
+}
+
+func TestRequireExtracting(t *testing.T) {
+	fixture := new(fixtureT)
+	require.ThatSlice(fixture, []string{"Frodo", "Sam"}).
+		ExtractingInts(func(name string) int { return len(name) }).
+		Contains(4)
+	assertStopped(t, fixture, "expected slice to contain <[4]>, but got <[5 3]>")
+}
+
+func TestRequireEventuallyStops(t *testing.T) {
+	fixture := new(fixtureT)
+	require.Eventually(fixture, 10*time.Millisecond, time.Millisecond, func(c assert.TestingT) {
+		assert.ThatBool(c, false).IsTrue()
+	})
+	assertStopped(t, fixture, "expected value to be true, but got <false>")
+}
+
+type errorOnlyT struct {
+	message string
+}
+
+func (e *errorOnlyT) Errorf(format string, args ...any) {
+	e.message = fmt.Sprintf(format, args...)
+}
+
+func TestRequireWithoutFailNow(t *testing.T) {
+	fixture := new(errorOnlyT)
+	require.ThatString(fixture, "Frodo").IsEmpty()
+	expected := "expected string to be empty, but got <Frodo>\n(the test could not be stopped, it has no FailNow method)"
+	if fixture.message != expected {
+		t.Errorf("expected to fail with error message %q, but got %#v", expected, fixture.message)
+	}
+}